@@ -32,6 +32,9 @@ func DefaultComponents() []any {
 			*BlobSidecar, *BlobSidecars, *Deposit, *ExecutionPayloadHeader,
 			*Genesis, *Logger,
 		],
+		components.ProvideArchivalBlockStore[
+			*BeaconBlock, *BeaconBlockBody, *BeaconBlockHeader,
+		],
 		components.ProvideAttributesFactory[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
 			*ExecutionPayloadHeader, *KVStore, *Logger,
@@ -99,6 +102,10 @@ func DefaultComponents() []any {
 		components.ProvideExecutionEngine[
 			*ExecutionPayload, *ExecutionPayloadHeader, *Logger,
 		],
+		components.ProvideGenesisExporter[
+			*AvailabilityStore, *BeaconState, *BeaconStateMarshallable,
+			*Deposit, *ExecutionPayloadHeader, *Genesis, *StorageBackend,
+		],
 		components.ProvideJWTSecret,
 		components.ProvideLocalBuilder[
 			*BeaconBlockHeader, *BeaconState, *BeaconStateMarshallable,
@@ -51,7 +51,7 @@ type StateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[WithdrawalCredentialsT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -104,7 +104,7 @@ func NewStateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT, WithdrawalsT, WithdrawalCredentialsT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[WithdrawalCredentialsT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -206,8 +206,7 @@ func (sp *StateProcessor[
 		}
 
 		// Process the Epoch Boundary.
-		boundary := (stateSlot.Unwrap() + 1) == 0
-		if boundary {
+		if sp.cs.SlotsUntilEpochBoundary(stateSlot.Unwrap()) == 1 {
 			if epochValidatorUpdates, err =
 				sp.processEpoch(st); err != nil {
 				return nil, err
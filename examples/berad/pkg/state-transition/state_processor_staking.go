@@ -126,14 +126,14 @@ func (sp *StateProcessor[
 	epoch = sp.cs.SlotToEpoch(slot.Unwrap())
 
 	// Verify that the message was signed correctly.
-	var d ForkDataT
+	var fd ForkDataT
+	fd = fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	)
 	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[common.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
+		fd.ComputeDomain(sp.cs.DomainTypeDeposit()),
 		sp.signer.VerifySignature,
 	); err != nil {
 		return err
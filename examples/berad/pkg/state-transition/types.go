@@ -121,20 +121,17 @@ type Context interface {
 }
 
 // Deposit is the interface for a deposit.
-type Deposit[
-	ForkDataT any,
-	WithdrawlCredentialsT ~[32]byte,
-] interface {
+type Deposit[WithdrawlCredentialsT ~[32]byte] interface {
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials.
 	GetWithdrawalCredentials() WithdrawlCredentialsT
-	// VerifySignature verifies the deposit and creates a validator.
+	// VerifySignature verifies the deposit signature over the given signing
+	// domain.
 	VerifySignature(
-		forkData ForkDataT,
-		domainType common.DomainType,
+		domain common.Domain,
 		signatureVerificationFn func(
 			pubkey crypto.BLSPubkey,
 			message []byte, signature crypto.BLSSignature,
@@ -195,6 +192,8 @@ type ExecutionEngine[
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
 	New(common.Version, common.Root) ForkDataT
+	// ComputeDomain returns the signing domain for the given domain type.
+	ComputeDomain(domainType common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot returns the signing root for the fork data.
 	ComputeRandaoSigningRoot(
 		domainType common.DomainType,
@@ -115,7 +115,10 @@ func New[
 		constraints.Empty[ForkT]
 		constraints.SSZMarshallable
 	},
-	ValidatorT Validator[ValidatorT],
+	ValidatorT interface {
+		Validator[ValidatorT]
+		GetWithdrawalCredentials() WithdrawalCredentialsT
+	},
 	ValidatorsT ~[]ValidatorT,
 	WithdrawalT interface {
 		constraints.Empty[WithdrawalT]
@@ -126,6 +129,7 @@ func New[
 		constraints.Empty[WithdrawalsT]
 		constraints.SSZMarshallable
 	},
+	WithdrawalCredentialsT ~[32]byte,
 ](
 	kss store.KVStoreService,
 	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
@@ -205,7 +209,9 @@ func New[
 			keys.ValidatorByIndexPrefixHumanReadable,
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[ValidatorT]{},
-			index.NewValidatorsIndex[ValidatorT](schemaBuilder),
+			index.NewValidatorsIndex[ValidatorT, WithdrawalCredentialsT](
+				schemaBuilder,
+			),
 		),
 		randaoMix: sdkcollections.NewMap(
 			schemaBuilder,
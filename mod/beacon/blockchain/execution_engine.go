@@ -22,9 +22,15 @@ package blockchain
 
 import (
 	"context"
+	"io"
+	"net"
+	"syscall"
+	"time"
 
 	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 )
 
 // sendPostBlockFCU sends a forkchoice update to the execution client.
@@ -44,10 +50,21 @@ func (s *Service[
 		return
 	}
 
-	if !s.shouldBuildOptimisticPayloads() && s.localBuilder.Enabled() {
+	// Blocks are final once committed by CometBFT, hence the execution
+	// payload of the block being processed is the finalized one.
+	finalizedHash := blk.GetBody().GetExecutionPayload().GetBlockHash()
+
+	startTime := time.Now()
+	switch {
+	case s.shouldBuildOptimisticPayloads():
+		defer s.metrics.measurePostBlockFCU(fcuPathOptimistic, startTime)
+		s.sendNextFCUWithoutAttributes(ctx, blk, lph, finalizedHash)
+	case s.localBuilder.Enabled():
+		defer s.metrics.measurePostBlockFCU(fcuPathWithAttrs, startTime)
 		s.sendNextFCUWithAttributes(ctx, st, blk, lph)
-	} else {
-		s.sendNextFCUWithoutAttributes(ctx, blk, lph)
+	default:
+		defer s.metrics.measurePostBlockFCU(fcuPathNoAttrs, startTime)
+		s.sendNextFCUWithoutAttributes(ctx, blk, lph, finalizedHash)
 	}
 }
 
@@ -76,11 +93,24 @@ func (s *Service[
 
 	prevBlockRoot := blk.HashTreeRoot()
 	payloadTime := blk.GetBody().GetExecutionPayload().GetTimestamp()
+	nextPayloadTime := payloadtime.Next(s.chainSpec, payloadTime)
+
+	// Enforce the minimum block time of the chain spec, if any.
+	if minPayloadTime := payloadTime.Unwrap() +
+		s.chainSpec.MinBlockTime(); nextPayloadTime < minPayloadTime {
+		s.logger.Info(
+			"applying minimum block time to next payload timestamp",
+			"timestamp", nextPayloadTime,
+			"min_timestamp", minPayloadTime,
+		)
+		nextPayloadTime = minPayloadTime
+	}
+
 	if _, err = s.localBuilder.RequestPayloadAsync(
 		ctx,
 		stCopy,
 		blk.GetSlot()+1,
-		payloadtime.Next(s.chainSpec, payloadTime),
+		nextPayloadTime,
 		prevBlockRoot,
 		lph.GetBlockHash(),
 		lph.GetParentHash(),
@@ -94,7 +124,8 @@ func (s *Service[
 }
 
 // sendNextFCUWithoutAttributes sends a forkchoice update to the
-// execution client without attributes.
+// execution client without attributes. The safe block is kept at the parent
+// of the head, while the finalized block is the given finalized hash.
 func (s *Service[
 	_, BeaconBlockT, _, _, _, _, _,
 	ExecutionPayloadHeaderT, _, PayloadAttributesT,
@@ -102,23 +133,61 @@ func (s *Service[
 	ctx context.Context,
 	blk BeaconBlockT,
 	lph ExecutionPayloadHeaderT,
+	finalizedHash common.ExecutionHash,
 ) {
-	if _, _, err := s.executionEngine.NotifyForkchoiceUpdate(
-		ctx,
-		// TODO: Switch to New().
-		engineprimitives.
-			BuildForkchoiceUpdateRequestNoAttrs[PayloadAttributesT](
-			&engineprimitives.ForkchoiceStateV1{
-				HeadBlockHash:      lph.GetBlockHash(),
-				SafeBlockHash:      lph.GetParentHash(),
-				FinalizedBlockHash: lph.GetParentHash(),
-			},
-			s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
-		),
-	); err != nil {
-		s.logger.Error(
-			"failed to send forkchoice update without attributes",
+	// TODO: Switch to New().
+	req := engineprimitives.
+		BuildForkchoiceUpdateRequestNoAttrs[PayloadAttributesT](
+		&engineprimitives.ForkchoiceStateV1{
+			HeadBlockHash:      lph.GetBlockHash(),
+			SafeBlockHash:      lph.GetParentHash(),
+			FinalizedBlockHash: finalizedHash,
+		},
+		s.chainSpec.ActiveForkVersionForSlot(blk.GetSlot()),
+	)
+
+	// Retry with exponential backoff on connection errors, which are common
+	// while the execution client restarts.
+	delay := s.fcuRetryBaseDelay
+	for attempt := uint64(1); ; attempt++ {
+		_, _, err := s.executionEngine.NotifyForkchoiceUpdate(ctx, req)
+		if err == nil {
+			return
+		}
+		if attempt > s.fcuRetries || !isConnectionError(err) {
+			s.logger.Error(
+				"failed to send forkchoice update without attributes",
+				"error", err,
+			)
+			return
+		}
+
+		s.metrics.markForkchoiceUpdateRetry(attempt)
+		s.logger.Warn(
+			"retrying forkchoice update without attributes",
+			"attempt", attempt,
+			"delay", delay,
 			"error", err,
 		)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
 	}
 }
+
+// isConnectionError returns true if the given error is a connection-level
+// error talking to the execution client, as opposed to an error returned by
+// the execution client itself.
+func isConnectionError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.IsAny(
+		err,
+		io.EOF,
+		io.ErrUnexpectedEOF,
+		syscall.ECONNREFUSED,
+		syscall.ECONNRESET,
+	)
+}
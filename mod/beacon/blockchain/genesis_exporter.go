@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import (
+	"context"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+)
+
+// GenesisExporter exports the beacon state held in a context as the genesis
+// of a new chain.
+type GenesisExporter[
+	AvailabilityStoreT any,
+	BeaconStateT ExportableBeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorsT,
+	],
+	BeaconStateMarshallableT constraints.SSZMarshaler,
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	ExecutionPayloadHeaderT any,
+	GenesisT GenesisFactory[GenesisT, DepositT, ExecutionPayloadHeaderT],
+	ValidatorT Validator[WithdrawalCredentialsT],
+	ValidatorsT ~[]ValidatorT,
+	WithdrawalCredentialsT any,
+] struct {
+	// storageBackend is the backend the beacon state is read from.
+	storageBackend StorageBackend[AvailabilityStoreT, BeaconStateT]
+	// chainSpec holds the chain specifications.
+	chainSpec common.ChainSpec
+}
+
+// NewGenesisExporter creates a new genesis exporter.
+func NewGenesisExporter[
+	AvailabilityStoreT any,
+	BeaconStateT ExportableBeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, ValidatorsT,
+	],
+	BeaconStateMarshallableT constraints.SSZMarshaler,
+	DepositT Deposit[DepositT, WithdrawalCredentialsT],
+	ExecutionPayloadHeaderT any,
+	GenesisT GenesisFactory[GenesisT, DepositT, ExecutionPayloadHeaderT],
+	ValidatorT Validator[WithdrawalCredentialsT],
+	ValidatorsT ~[]ValidatorT,
+	WithdrawalCredentialsT any,
+](
+	storageBackend StorageBackend[AvailabilityStoreT, BeaconStateT],
+	chainSpec common.ChainSpec,
+) *GenesisExporter[
+	AvailabilityStoreT, BeaconStateT, BeaconStateMarshallableT, DepositT,
+	ExecutionPayloadHeaderT, GenesisT, ValidatorT, ValidatorsT,
+	WithdrawalCredentialsT,
+] {
+	return &GenesisExporter[
+		AvailabilityStoreT, BeaconStateT, BeaconStateMarshallableT, DepositT,
+		ExecutionPayloadHeaderT, GenesisT, ValidatorT, ValidatorsT,
+		WithdrawalCredentialsT,
+	]{
+		storageBackend: storageBackend,
+		chainSpec:      chainSpec,
+	}
+}
+
+// ExportGenesis returns the JSON genesis of the beacon state held in the
+// given context, along with the validator set of that state. The genesis
+// deposits are derived from the validators with a non-zero effective balance
+// and carry no signatures, since those are not retained in the state. They
+// must therefore be signed again before the genesis can start a new chain.
+func (e *GenesisExporter[
+	_, _, _, DepositT, _, GenesisT, _, _, _,
+]) ExportGenesis(
+	ctx context.Context,
+) ([]byte, transition.ValidatorUpdates, error) {
+	st := e.storageBackend.StateFromContext(ctx)
+	slot, err := st.GetSlot()
+	if err != nil {
+		return nil, nil, err
+	}
+	header, err := st.GetLatestExecutionPayloadHeader()
+	if err != nil {
+		return nil, nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		deposit  DepositT
+		genesis  GenesisT
+		deposits = make([]DepositT, 0, len(validators))
+		updates  = make(transition.ValidatorUpdates, 0, len(validators))
+	)
+	for _, val := range validators {
+		balance := val.GetEffectiveBalance()
+		if balance == 0 {
+			continue
+		}
+		deposits = append(deposits, deposit.New(
+			val.GetPubkey(),
+			val.GetWithdrawalCredentials(),
+			balance,
+			crypto.BLSSignature{},
+			uint64(len(deposits)),
+		))
+		updates = append(updates, &transition.ValidatorUpdate{
+			Pubkey:           val.GetPubkey(),
+			EffectiveBalance: balance,
+		})
+	}
+
+	bz, err := json.Marshal(genesis.New(
+		version.FromUint32[common.Version](
+			e.chainSpec.ActiveForkVersionForSlot(slot),
+		),
+		deposits,
+		header,
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+	return bz, updates, nil
+}
+
+// ExportStateSSZ returns the SSZ encoding of the beacon state held in the
+// given context.
+func (e *GenesisExporter[
+	_, _, _, _, _, _, _, _, _,
+]) ExportStateSSZ(ctx context.Context) ([]byte, error) {
+	st, err := e.storageBackend.StateFromContext(ctx).GetMarshallable()
+	if err != nil {
+		return nil, err
+	}
+	return st.MarshalSSZ()
+}
@@ -21,11 +21,25 @@
 package blockchain
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// Labels for the forkchoice update path taken after processing a block.
+const (
+	// fcuPathWithAttrs is the path sending a forkchoice update with payload
+	// attributes, to build the payload for the next slot.
+	fcuPathWithAttrs = "fcu_with_attrs"
+	// fcuPathNoAttrs is the path sending a forkchoice update without payload
+	// attributes, as the local builder is disabled.
+	fcuPathNoAttrs = "fcu_no_attrs"
+	// fcuPathOptimistic is the path sending a forkchoice update without
+	// payload attributes, as the payload is built optimistically.
+	fcuPathOptimistic = "fcu_optimistic"
+)
+
 // chainMetrics is a struct that contains metrics for the chain.
 type chainMetrics struct {
 	// sink is the sink for the metrics.
@@ -108,6 +122,27 @@ func (cm *chainMetrics) markOptimisticPayloadBuildFailure(
 	)
 }
 
+// measurePostBlockFCU counts the forkchoice updates sent after processing a
+// block and measures their duration, labeled by the path taken.
+func (cm *chainMetrics) measurePostBlockFCU(path string, start time.Time) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.post_block_fcu", "path", path,
+	)
+	cm.sink.MeasureSince(
+		"beacon_kit.blockchain.post_block_fcu_duration", start, "path", path,
+	)
+}
+
+// markForkchoiceUpdateRetry increments the counter for the number of times
+// a forkchoice update was retried after an execution client connection error.
+func (cm *chainMetrics) markForkchoiceUpdateRetry(attempt uint64) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.blockchain.forkchoice_update_retry",
+		"attempt",
+		strconv.FormatUint(attempt, 10),
+	)
+}
+
 // measureStateRootVerificationTime measures the time taken to verify the state
 // root of a block.
 // It records the duration from the provided start time to the current time.
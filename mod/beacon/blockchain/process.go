@@ -24,8 +24,12 @@ import (
 	"context"
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
 // ProcessGenesisData processes the genesis state and initializes the beacon
@@ -51,18 +55,113 @@ func (s *Service[
 ]) ProcessBeaconBlock(
 	ctx context.Context,
 	blk BeaconBlockT,
+) (transition.ValidatorUpdates, error) {
+	st := s.storageBackend.StateFromContext(ctx)
+	valUpdates, err := s.receiveBeaconBlock(ctx, st, blk)
+	if err != nil {
+		return nil, err
+	}
+
+	// A simulated block must not be sent to the execution client.
+	if !transition.IsSimulation(ctx) {
+		go s.sendPostBlockFCU(ctx, st, blk)
+	}
+
+	return valUpdates.CanonicalSort(), nil
+}
+
+// BlockProcessStats holds statistics about a processed beacon block.
+type BlockProcessStats struct {
+	// GasUsed is the gas used by the execution payload of the block.
+	GasUsed math.U64
+	// BlobCount is the number of blob KZG commitments in the block.
+	BlobCount int
+	// DepositCount is the number of deposits in the block.
+	DepositCount int
+}
+
+// ProcessBeaconBlockWithStats processes the beacon block like
+// ProcessBeaconBlock, and also returns statistics about it computed from the
+// block body.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) ProcessBeaconBlockWithStats(
+	ctx context.Context,
+	blk BeaconBlockT,
+) (transition.ValidatorUpdates, BlockProcessStats, error) {
+	valUpdates, err := s.ProcessBeaconBlock(ctx, blk)
+	if err != nil {
+		return nil, BlockProcessStats{}, err
+	}
+
+	body := blk.GetBody()
+	return valUpdates, BlockProcessStats{
+		GasUsed:      body.GetExecutionPayload().GetGasUsed(),
+		BlobCount:    len(body.GetBlobKzgCommitments()),
+		DepositCount: len(body.GetDeposits()),
+	}, nil
+}
+
+// ReceiveBlockBatch processes the given beacon blocks in order on the same
+// state, e.g. when syncing a burst of blocks, and returns their merged
+// validator updates, later updates taking precedence.
+//
+// Processing stops at the first block that fails. The number of blocks that
+// were received is returned in any case, and is thus the index of the failed
+// block on error. The forkchoice is only updated once, after the last block.
+func (s *Service[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _,
+]) ReceiveBlockBatch(
+	ctx context.Context,
+	blks []BeaconBlockT,
+) (transition.ValidatorUpdates, int, error) {
+	var (
+		st         = s.storageBackend.StateFromContext(ctx)
+		valUpdates transition.ValidatorUpdates
+	)
+	for i, blk := range blks {
+		updates, err := s.receiveBeaconBlock(ctx, st, blk)
+		if err != nil {
+			return valUpdates.CanonicalSort(), i, errors.Wrapf(
+				err, "failed to receive block %d of batch", i,
+			)
+		}
+		valUpdates = append(valUpdates, updates...)
+	}
+
+	// A simulated block must not be sent to the execution client.
+	if len(blks) > 0 && !transition.IsSimulation(ctx) {
+		go s.sendPostBlockFCU(ctx, st, blks[len(blks)-1])
+	}
+
+	return valUpdates.CanonicalSort(), len(blks), nil
+}
+
+// receiveBeaconBlock validates and processes the block on the given state,
+// notifying subscribers that it is finalized.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+]) receiveBeaconBlock(
+	ctx context.Context,
+	st BeaconStateT,
+	blk BeaconBlockT,
 ) (transition.ValidatorUpdates, error) {
 	// If the block is nil, exit early.
 	if blk.IsNil() {
 		return nil, ErrNilBlk
 	}
 
-	st := s.storageBackend.StateFromContext(ctx)
 	valUpdates, err := s.executeStateTransition(ctx, st, blk)
 	if err != nil {
 		return nil, err
 	}
 
+	// A simulated block is never finalized, so we skip the availability
+	// check and must not notify subscribers.
+	if transition.IsSimulation(ctx) {
+		return valUpdates, nil
+	}
+
 	// If the blobs needed to process the block are not available, we
 	// return an error. It is safe to use the slot off of the beacon block
 	// since it has been verified as correct already.
@@ -85,9 +184,24 @@ func (s *Service[
 		return nil, err
 	}
 
-	go s.sendPostBlockFCU(ctx, st, blk)
+	s.logUpcomingFork(blk.GetSlot())
+	return valUpdates, nil
+}
 
-	return valUpdates.CanonicalSort(), nil
+// logUpcomingFork logs the next scheduled fork once, when it activates one
+// epoch after the given slot.
+func (s *Service[
+	_, _, _, _, _, _, _, _, _, _,
+]) logUpcomingFork(slot math.Slot) {
+	forkVersion, forkSlot, ok := s.chainSpec.NextForkVersionAfterSlot(slot)
+	if !ok || forkSlot-slot != math.Slot(s.chainSpec.SlotsPerEpoch()) {
+		return
+	}
+	s.logger.Info(
+		"Upcoming fork scheduled 🍴",
+		"version", version.FromUint32[common.Version](forkVersion),
+		"slot", forkSlot,
+	)
 }
 
 // executeStateTransition runs the stf.
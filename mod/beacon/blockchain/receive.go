@@ -26,6 +26,7 @@ import (
 
 	engineerrors "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/errors"
 	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -51,7 +52,10 @@ func (s *Service[
 		s.logger.Warn(
 			"Aborting block verification - beacon block not found in proposal",
 		)
-		return errors.WrapNonFatal(ErrNilBlk)
+		return &BlockVerificationError{
+			Reason: ReasonNilBlock,
+			Err:    errors.WrapNonFatal(ErrNilBlk),
+		}
 	}
 
 	s.logger.Info(
@@ -79,7 +83,10 @@ func (s *Service[
 			go s.handleRebuildPayloadForRejectedBlock(ctx, preState)
 		}
 
-		return err
+		return &BlockVerificationError{
+			Reason: s.classifyRejectedBlock(preState, blk),
+			Err:    err,
+		}
 	}
 
 	s.logger.Info(
@@ -130,6 +137,44 @@ func (s *Service[
 	return nil
 }
 
+// classifyRejectedBlock determines why the block failed verification against
+// the given pre-state. It mirrors the header checks of the state transition,
+// and is thus only run once the block has been rejected.
+func (s *Service[
+	_, BeaconBlockT, _, _, BeaconStateT, _, _, _, _, _,
+]) classifyRejectedBlock(
+	st BeaconStateT,
+	blk BeaconBlockT,
+) BlockVerificationReason {
+	slot, err := st.GetSlot()
+	if err != nil {
+		return ReasonInvalidBlock
+	}
+
+	// Each slot holds a block, so the block must be for the next slot.
+	switch {
+	case blk.GetSlot() > slot+1:
+		return ReasonFutureSlot
+	case blk.GetSlot() <= slot:
+		return ReasonPastSlot
+	}
+
+	// The state root of the latest block header is only filled in when
+	// processing the next slot, so we fill it in to compute the parent root.
+	latestHeader, err := st.GetLatestBlockHeader()
+	if err != nil {
+		return ReasonInvalidBlock
+	}
+	if (latestHeader.GetStateRoot() == common.Root{}) {
+		latestHeader.SetStateRoot(st.HashTreeRoot())
+	}
+	if latestHeader.HashTreeRoot() != blk.GetParentBlockRoot() {
+		return ReasonBadParent
+	}
+
+	return ReasonInvalidBlock
+}
+
 // shouldBuildOptimisticPayloads returns true if optimistic
 // payload builds are enabled.
 func (s *Service[
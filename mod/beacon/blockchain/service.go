@@ -23,6 +23,7 @@ package blockchain
 import (
 	"context"
 	"sync"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -35,7 +36,7 @@ import (
 type Service[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
-	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
 		BeaconStateT, BeaconBlockHeaderT, ExecutionPayloadHeaderT,
@@ -77,6 +78,12 @@ type Service[
 	// optimisticPayloadBuilds is a flag used when the optimistic payload
 	// builder is enabled.
 	optimisticPayloadBuilds bool
+	// fcuRetries is the number of times a forkchoice update is retried on
+	// execution client connection errors.
+	fcuRetries uint64
+	// fcuRetryBaseDelay is the delay before the first forkchoice update
+	// retry, doubled on every subsequent retry.
+	fcuRetryBaseDelay time.Duration
 	// forceStartupSyncOnce is used to force a sync of the startup head.
 	forceStartupSyncOnce *sync.Once
 
@@ -92,7 +99,7 @@ type Service[
 func NewService[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT],
 	BeaconBlockT BeaconBlock[BeaconBlockBodyT],
-	BeaconBlockBodyT BeaconBlockBody[ExecutionPayloadT],
+	BeaconBlockBodyT BeaconBlockBody[DepositT, ExecutionPayloadT],
 	BeaconBlockHeaderT BeaconBlockHeader,
 	BeaconStateT ReadOnlyBeaconState[
 		BeaconStateT, BeaconBlockHeaderT,
@@ -122,6 +129,8 @@ func NewService[
 	],
 	telemetrySink TelemetrySink,
 	optimisticPayloadBuilds bool,
+	fcuRetries uint64,
+	fcuRetryBaseDelay time.Duration,
 ) *Service[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, DepositT, ExecutionPayloadT, ExecutionPayloadHeaderT,
@@ -141,6 +150,8 @@ func NewService[
 		stateProcessor:          stateProcessor,
 		metrics:                 newChainMetrics(telemetrySink),
 		optimisticPayloadBuilds: optimisticPayloadBuilds,
+		fcuRetries:              fcuRetries,
+		fcuRetryBaseDelay:       fcuRetryBaseDelay,
 		forceStartupSyncOnce:    new(sync.Once),
 		subFinalBlkReceived:     make(chan async.Event[BeaconBlockT]),
 		subBlockReceived:        make(chan async.Event[BeaconBlockT]),
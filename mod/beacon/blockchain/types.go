@@ -27,6 +27,8 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
@@ -48,6 +50,8 @@ type BeaconBlock[BeaconBlockBodyT any] interface {
 	constraints.Nillable
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetParentBlockRoot returns the root of the parent beacon block.
+	GetParentBlockRoot() common.Root
 	// GetStateRoot returns the state root of the beacon block.
 	GetStateRoot() common.Root
 	// GetBody returns the body of the beacon block.
@@ -55,9 +59,14 @@ type BeaconBlock[BeaconBlockBodyT any] interface {
 }
 
 // BeaconBlockBody represents the interface for the beacon block body.
-type BeaconBlockBody[ExecutionPayloadT any] interface {
+type BeaconBlockBody[DepositT, ExecutionPayloadT any] interface {
 	constraints.SSZMarshallableRootable
 	constraints.Nillable
+	// GetBlobKzgCommitments returns the blob KZG commitments of the beacon
+	// block body.
+	GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
+	// GetDeposits returns the deposits of the beacon block body.
+	GetDeposits() []DepositT
 	// GetExecutionPayload returns the execution payload of the beacon block
 	// body.
 	GetExecutionPayload() ExecutionPayloadT
@@ -80,8 +89,27 @@ type BlobSidecars interface {
 	Len() int
 }
 
+// Deposit is the interface for a deposit.
+type Deposit[T, WithdrawalCredentialsT any] interface {
+	// New creates a new deposit.
+	New(
+		crypto.BLSPubkey,
+		WithdrawalCredentialsT,
+		math.Gwei,
+		crypto.BLSSignature,
+		uint64,
+	) T
+}
+
 // ExecutionEngine is the interface for the execution engine.
 type ExecutionEngine[PayloadAttributesT any] interface {
+	// GetPayloadBodiesByRange returns the payload bodies of the count blocks
+	// starting at the start block number, with nil entries for the blocks
+	// unknown to the execution client.
+	GetPayloadBodiesByRange(
+		ctx context.Context,
+		start, count uint64,
+	) ([]*engineprimitives.ExecutionPayloadBodyV1, error)
 	// NotifyForkchoiceUpdate notifies the execution client of a forkchoice
 	// update.
 	NotifyForkchoiceUpdate(
@@ -93,6 +121,8 @@ type ExecutionEngine[PayloadAttributesT any] interface {
 // ExecutionPayload is the interface for the execution payload.
 type ExecutionPayload interface {
 	ExecutionPayloadHeader
+	// GetGasUsed returns the gas used by the execution payload.
+	GetGasUsed() math.U64
 }
 
 // ExecutionPayloadHeader is the interface for the execution payload header.
@@ -105,6 +135,23 @@ type ExecutionPayloadHeader interface {
 	GetParentHash() common.ExecutionHash
 }
 
+// ExportableBeaconState defines the interface for accessing the components of
+// the beacon state that are exported.
+type ExportableBeaconState[
+	BeaconStateMarshallableT any,
+	ExecutionPayloadHeaderT any,
+	ValidatorsT any,
+] interface {
+	ReadOnlyValidators[ValidatorsT]
+	// GetLatestExecutionPayloadHeader returns the most recent execution payload
+	// header.
+	GetLatestExecutionPayloadHeader() (ExecutionPayloadHeaderT, error)
+	// GetMarshallable returns the marshallable version of the beacon state.
+	GetMarshallable() (BeaconStateMarshallableT, error)
+	// GetSlot retrieves the current slot of the beacon state.
+	GetSlot() (math.Slot, error)
+}
+
 // Genesis is the interface for the genesis.
 type Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 	// GetForkVersion returns the fork version.
@@ -115,6 +162,12 @@ type Genesis[DepositT any, ExecutionPayloadHeaderT any] interface {
 	GetExecutionPayloadHeader() ExecutionPayloadHeaderT
 }
 
+// GenesisFactory is the interface for creating a genesis.
+type GenesisFactory[T, DepositT, ExecutionPayloadHeaderT any] interface {
+	// New creates a new genesis.
+	New(common.Version, []DepositT, ExecutionPayloadHeaderT) T
+}
+
 // LocalBuilder is the interface for the builder service.
 type LocalBuilder[BeaconStateT any] interface {
 	// Enabled returns true if the local builder is enabled.
@@ -169,6 +222,13 @@ type ReadOnlyBeaconState[
 	HashTreeRoot() common.Root
 }
 
+// ReadOnlyValidators defines the interface for accessing the validator set of
+// the beacon state.
+type ReadOnlyValidators[ValidatorsT any] interface {
+	// GetValidators retrieves all validators of the beacon state.
+	GetValidators() (ValidatorsT, error)
+}
+
 // StateProcessor defines the interface for processing various state transitions
 // in the beacon chain.
 type StateProcessor[
@@ -222,4 +282,15 @@ type TelemetrySink interface {
 	MeasureSince(key string, start time.Time, args ...string)
 }
 
+// Validator is the interface for a validator.
+type Validator[WithdrawalCredentialsT any] interface {
+	// GetPubkey returns the public key of the validator.
+	GetPubkey() crypto.BLSPubkey
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// GetWithdrawalCredentials returns the withdrawal credentials of the
+	// validator.
+	GetWithdrawalCredentials() WithdrawalCredentialsT
+}
+
 type ValidatorUpdates = transition.ValidatorUpdates
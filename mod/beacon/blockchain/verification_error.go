@@ -0,0 +1,82 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package blockchain
+
+import "fmt"
+
+// BlockVerificationReason is the class of failure of the verification of an
+// incoming beacon block.
+type BlockVerificationReason uint8
+
+const (
+	// ReasonInvalidBlock is the reason of a block that failed the state
+	// transition for any other reason, e.g. a bad signature or state root.
+	ReasonInvalidBlock BlockVerificationReason = iota
+	// ReasonNilBlock is the reason of a missing block.
+	ReasonNilBlock
+	// ReasonFutureSlot is the reason of a block for a slot later than the
+	// next one.
+	ReasonFutureSlot
+	// ReasonPastSlot is the reason of a block for a slot that was already
+	// processed.
+	ReasonPastSlot
+	// ReasonBadParent is the reason of a block that does not build on the
+	// latest block.
+	ReasonBadParent
+)
+
+// String returns the string representation of the reason.
+func (r BlockVerificationReason) String() string {
+	switch r {
+	case ReasonInvalidBlock:
+		return "invalid block"
+	case ReasonNilBlock:
+		return "nil block"
+	case ReasonFutureSlot:
+		return "future slot"
+	case ReasonPastSlot:
+		return "past slot"
+	case ReasonBadParent:
+		return "bad parent"
+	default:
+		return fmt.Sprintf("unknown reason %d", r)
+	}
+}
+
+// BlockVerificationError is returned when an incoming beacon block fails
+// verification, classifying the failure so that callers can e.g. score the
+// peer that sent the block accordingly.
+type BlockVerificationError struct {
+	// Reason is the class of the failure.
+	Reason BlockVerificationReason
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *BlockVerificationError) Error() string {
+	return fmt.Sprintf("block verification failed (%s): %v", e.Reason, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *BlockVerificationError) Unwrap() error {
+	return e.Err
+}
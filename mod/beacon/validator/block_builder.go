@@ -22,14 +22,17 @@ package validator
 
 import (
 	"context"
-	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
+	"cosmossdk.io/collections"
 	payloadtime "github.com/berachain/beacon-kit/mod/beacon/payload-time"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -61,9 +64,8 @@ func (s *Service[
 
 	// Prepare the state such that it is ready to build a block for
 	// the requested slot
-	if _, err := s.stateProcessor.ProcessSlots(
-		st,
-		slotData.GetSlot(),
+	if err := PrepareStateForBuilding(
+		s.stateProcessor, st, slotData.GetSlot(), s.cfg.MaxSlotCatchup,
 	); err != nil {
 		return blk, sidecars, err
 	}
@@ -85,6 +87,15 @@ func (s *Service[
 
 	// Get the payload for the block.
 	envelope, err := s.retrieveExecutionPayload(ctx, st, blk)
+	if err != nil && s.cfg.EnableEmptyBlockFallback {
+		s.logger.Warn(
+			"Failed to retrieve execution payload, proposing empty block ⚠️ ",
+			"slot", slotData.GetSlot().Base10(),
+			"error", err,
+		)
+		s.metrics.proposedEmptyBlock(slotData.GetSlot())
+		envelope, err = s.emptyExecutionPayload(slotData.GetSlot()), nil
+	}
 	if err != nil {
 		return blk, sidecars, err
 	} else if envelope == nil {
@@ -149,11 +160,14 @@ func (s *Service[
 	}
 
 	// Get the proposer index for the slot.
-	proposerIndex, err := st.ValidatorIndexByPubkey(
-		s.signer.PublicKey(),
-	)
-	if err != nil {
-		return blk, err
+	pubkey := s.signer.PublicKey()
+	proposerIndex, err := st.ValidatorIndexByPubkey(pubkey)
+	if errors.Is(err, collections.ErrNotFound) {
+		return blk, errors.Wrapf(ErrSignerNotInValidatorSet, "pubkey %s", pubkey)
+	} else if err != nil {
+		return blk, errors.Wrapf(
+			err, "failed to get validator index by pubkey %s", pubkey,
+		)
 	}
 
 	return blk.NewWithVersion(
@@ -215,6 +229,12 @@ func (s *Service[
 			err,
 		)
 
+		// Leave it to the caller to decide how to propose without a payload
+		// when the execution client is too slow.
+		if errors.Is(err, ErrPayloadTimeout) {
+			return nil, err
+		}
+
 		// The latest execution payload header will be from the previous block
 		// during the block building phase.
 		var lph ExecutionPayloadHeaderT
@@ -244,6 +264,29 @@ func (s *Service[
 	return envelope, nil
 }
 
+// emptyExecutionPayload returns an envelope holding an empty execution
+// payload and blobs bundle, used to propose an execution-less block.
+func (s *Service[
+	_, _, _, _, _, _, _, _, ExecutionPayloadT, _, _, _, _,
+]) emptyExecutionPayload(
+	slot math.Slot,
+) engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT] {
+	var payload ExecutionPayloadT
+	return &engineprimitives.ExecutionPayloadEnvelope[
+		ExecutionPayloadT,
+		*engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		],
+	]{
+		ExecutionPayload: payload.Empty(
+			s.chainSpec.ActiveForkVersionForSlot(slot),
+		),
+		BlobsBundle: &engineprimitives.BlobsBundleV1[
+			eip4844.KZGCommitment, eip4844.KZGProof, eip4844.Blob,
+		]{},
+	}
+}
+
 // BuildBlockBody assembles the block body with necessary components.
 func (s *Service[
 	_, BeaconBlockT, _, BeaconStateT, _, _, _, Eth1DataT, ExecutionPayloadT, _,
@@ -299,19 +342,16 @@ func (s *Service[
 		common.ExecutionHash{},
 	))
 
-	// Set the graffiti on the block body.
-	sizedGraffiti := bytes.ExtendToSize([]byte(s.cfg.Graffiti), bytes.B32Size)
-	graffiti, err := bytes.ToBytes32(sizedGraffiti)
-	if err != nil {
-		return fmt.Errorf("failed processing graffiti: %w", err)
-	}
-	body.SetGraffiti(graffiti)
-
 	// Get the epoch to find the active fork version.
 	epoch := s.chainSpec.SlotToEpoch(blk.GetSlot())
 	activeForkVersion := s.chainSpec.ActiveForkVersionForEpoch(
 		epoch,
 	)
+
+	// Set the graffiti on the block body.
+	body.SetGraffiti(stringToByteArray32(expandGraffiti(
+		s.graffiti, blk.GetSlot(), activeForkVersion, blk.GetProposerIndex(),
+	)))
 	if activeForkVersion >= version.DenebPlus {
 		// Set the attestations on the block body.
 		body.SetAttestations(slotData.GetAttestationData())
@@ -374,3 +414,27 @@ func (s *Service[
 
 	return st.HashTreeRoot(), nil
 }
+
+// expandGraffiti replaces the {slot}, {version} and {proposer} tokens in the
+// given graffiti with the slot, active fork version and proposer index of the
+// block being built.
+func expandGraffiti(
+	graffiti string,
+	slot math.Slot,
+	forkVersion uint32,
+	proposer math.ValidatorIndex,
+) string {
+	return strings.NewReplacer(
+		"{slot}", strconv.FormatUint(slot.Unwrap(), 10),
+		"{version}", strconv.FormatUint(uint64(forkVersion), 10),
+		"{proposer}", strconv.FormatUint(proposer.Unwrap(), 10),
+	).Replace(graffiti)
+}
+
+// stringToByteArray32 converts the given string to a 32 byte array, truncating
+// it if it is longer than 32 bytes.
+func stringToByteArray32(str string) common.Bytes32 {
+	var b common.Bytes32
+	copy(b[:], str)
+	return b
+}
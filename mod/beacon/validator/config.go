@@ -27,6 +27,14 @@ const (
 	// defaultEnableOptimisticPayloadBuilds is the default
 	// for enabling the optimistic payload builder.
 	defaultEnableOptimisticPayloadBuilds = true
+
+	// defaultMaxSlotCatchup is the default for the maximum number of slots
+	// the state is advanced by before building a block.
+	defaultMaxSlotCatchup = 32
+
+	// defaultEnableEmptyBlockFallback is the default for proposing a block
+	// without an execution payload when the payload cannot be retrieved.
+	defaultEnableEmptyBlockFallback = false
 )
 
 // Config is the validator configuration.
@@ -34,11 +42,21 @@ const (
 //nolint:lll // struct tags.
 type Config struct {
 	// Graffiti is the string that will be included in the
-	// graffiti field of the beacon block.
+	// graffiti field of the beacon block. The {slot}, {version} and
+	// {proposer} tokens are replaced with the values of the block.
 	Graffiti string `mapstructure:"graffiti"`
 
 	// EnableOptimisticPayloadBuilds is the optimistic block builder.
 	EnableOptimisticPayloadBuilds bool `mapstructure:"enable-optimistic-payload-builds"`
+
+	// MaxSlotCatchup is the maximum number of slots the state is advanced by
+	// before building a block, e.g. to catch up after missed slots.
+	MaxSlotCatchup uint64 `mapstructure:"max-slot-catchup"`
+
+	// EnableEmptyBlockFallback proposes a block with an empty execution
+	// payload instead of missing the slot when the payload cannot be
+	// retrieved from the execution client.
+	EnableEmptyBlockFallback bool `mapstructure:"enable-empty-block-fallback"`
 }
 
 // DefaultConfig returns the default fork configuration.
@@ -46,5 +64,7 @@ func DefaultConfig() Config {
 	return Config{
 		Graffiti:                      defaultGraffiti,
 		EnableOptimisticPayloadBuilds: defaultEnableOptimisticPayloadBuilds,
+		MaxSlotCatchup:                defaultMaxSlotCatchup,
+		EnableEmptyBlockFallback:      defaultEnableEmptyBlockFallback,
 	}
 }
@@ -37,4 +37,24 @@ var (
 	// ErrNilDepositIndexStart is an error for when the deposit index start is
 	// nil.
 	ErrNilDepositIndexStart = errors.New("nil deposit index start")
+
+	// ErrRequestedSlotInPast is an error for when the slot to build a block
+	// for is not ahead of the state.
+	ErrRequestedSlotInPast = errors.New("requested slot is in the past")
+
+	// ErrRequestedSlotTooFarAhead is an error for when the slot to build a
+	// block for is further ahead of the state than the validator catches up.
+	ErrRequestedSlotTooFarAhead = errors.New(
+		"requested slot is too far ahead",
+	)
+
+	// ErrPayloadTimeout is an error for when the execution payload is not
+	// retrieved before the deadline.
+	ErrPayloadTimeout = errors.New("timed out retrieving execution payload")
+
+	// ErrSignerNotInValidatorSet is an error for when the public key of the
+	// configured signer is not in the validator set.
+	ErrSignerNotInValidatorSet = errors.New(
+		"signer not in validator set",
+	)
 )
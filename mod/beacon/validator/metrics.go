@@ -74,3 +74,13 @@ func (cm *validatorMetrics) failedToRetrievePayload(
 		err.Error(),
 	)
 }
+
+// proposedEmptyBlock increments the counter for the number of times the
+// validator proposed a block with an empty execution payload.
+func (cm *validatorMetrics) proposedEmptyBlock(slot math.Slot) {
+	cm.sink.IncrementCounter(
+		"beacon_kit.validator.proposed_empty_block",
+		"slot",
+		slot.Base10(),
+	)
+}
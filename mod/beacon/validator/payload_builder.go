@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"context"
+	"time"
+
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// TimeoutPayloadBuilder wraps a PayloadBuilder and bounds every payload
+// request by a deadline, returning ErrPayloadTimeout once it passes.
+type TimeoutPayloadBuilder[BeaconStateT, ExecutionPayloadT any] struct {
+	PayloadBuilder[BeaconStateT, ExecutionPayloadT]
+	// timeout is the deadline applied to every payload request.
+	timeout time.Duration
+}
+
+// NewTimeoutPayloadBuilder returns a TimeoutPayloadBuilder wrapping the given
+// builder.
+func NewTimeoutPayloadBuilder[BeaconStateT, ExecutionPayloadT any](
+	builder PayloadBuilder[BeaconStateT, ExecutionPayloadT],
+	timeout time.Duration,
+) *TimeoutPayloadBuilder[BeaconStateT, ExecutionPayloadT] {
+	return &TimeoutPayloadBuilder[BeaconStateT, ExecutionPayloadT]{
+		PayloadBuilder: builder,
+		timeout:        timeout,
+	}
+}
+
+// RetrievePayload retrieves the payload for the given slot from the wrapped
+// builder.
+func (b *TimeoutPayloadBuilder[_, ExecutionPayloadT]) RetrievePayload(
+	ctx context.Context,
+	slot math.Slot,
+	parentBlockRoot common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return withPayloadTimeout(ctx, b.timeout, func(
+		ctx context.Context,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+		return b.PayloadBuilder.RetrievePayload(ctx, slot, parentBlockRoot)
+	})
+}
+
+// RequestPayloadSync requests a payload for the given slot from the wrapped
+// builder and blocks until it is delivered.
+func (b *TimeoutPayloadBuilder[
+	BeaconStateT, ExecutionPayloadT,
+]) RequestPayloadSync(
+	ctx context.Context,
+	st BeaconStateT,
+	slot math.Slot,
+	timestamp uint64,
+	parentBlockRoot common.Root,
+	headEth1BlockHash common.ExecutionHash,
+	finalEth1BlockHash common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	return withPayloadTimeout(ctx, b.timeout, func(
+		ctx context.Context,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+		return b.PayloadBuilder.RequestPayloadSync(
+			ctx, st, slot, timestamp, parentBlockRoot,
+			headEth1BlockHash, finalEth1BlockHash,
+		)
+	})
+}
+
+// withPayloadTimeout runs the given payload request, giving up on it once the
+// timeout expires even if the request does not observe its context.
+func withPayloadTimeout[ExecutionPayloadT any](
+	ctx context.Context,
+	timeout time.Duration,
+	request func(
+		context.Context,
+	) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error),
+) (engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT], error) {
+	type result struct {
+		envelope engineprimitives.BuiltExecutionPayloadEnv[ExecutionPayloadT]
+		err      error
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	results := make(chan result, 1)
+	go func() {
+		envelope, err := request(ctx)
+		results <- result{envelope: envelope, err: err}
+	}()
+
+	select {
+	case res := <-results:
+		return res.envelope, res.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, ErrPayloadTimeout
+		}
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	beaconState      struct{}
+	executionPayload struct{}
+	payloadEnvelope  struct {
+		engineprimitives.BuiltExecutionPayloadEnv[executionPayload]
+	}
+)
+
+// sleepingPayloadBuilder is a payload builder that sleeps for the given delay
+// before returning an empty envelope, ignoring its context.
+type sleepingPayloadBuilder struct {
+	delay time.Duration
+}
+
+func (b sleepingPayloadBuilder) RetrievePayload(
+	context.Context, math.Slot, common.Root,
+) (engineprimitives.BuiltExecutionPayloadEnv[executionPayload], error) {
+	time.Sleep(b.delay)
+	return payloadEnvelope{}, nil
+}
+
+func (b sleepingPayloadBuilder) RequestPayloadSync(
+	ctx context.Context, _ beaconState, slot math.Slot, _ uint64,
+	parentBlockRoot common.Root, _, _ common.ExecutionHash,
+) (engineprimitives.BuiltExecutionPayloadEnv[executionPayload], error) {
+	return b.RetrievePayload(ctx, slot, parentBlockRoot)
+}
+
+func TestTimeoutPayloadBuilder(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration
+		wantErr error
+	}{
+		{
+			name:    "Payload retrieved before the deadline",
+			delay:   0,
+			wantErr: nil,
+		},
+		{
+			name:    "Payload retrieved after the deadline",
+			delay:   time.Second,
+			wantErr: validator.ErrPayloadTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := validator.NewTimeoutPayloadBuilder[
+				beaconState, executionPayload,
+			](sleepingPayloadBuilder{delay: tt.delay}, 50*time.Millisecond)
+
+			envelope, err := builder.RetrievePayload(
+				context.Background(), 1, common.Root{},
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, envelope)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, envelope)
+			}
+
+			envelope, err = builder.RequestPayloadSync(
+				context.Background(), beaconState{}, 1, 0, common.Root{},
+				common.ExecutionHash{}, common.ExecutionHash{},
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, envelope)
+			} else {
+				require.NoError(t, err)
+				require.NotNil(t, envelope)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ package validator
 
 import (
 	"context"
+	"time"
 
 	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -43,7 +44,7 @@ type Service[
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
@@ -51,6 +52,8 @@ type Service[
 ] struct {
 	// cfg is the validator config.
 	cfg *Config
+	// graffiti is the graffiti included in the blocks built by this node.
+	graffiti string
 	// logger is a logger.
 	logger log.Logger
 	// chainSpec is the chain spec.
@@ -96,13 +99,14 @@ func NewService[
 	DepositT any,
 	DepositStoreT DepositStore[DepositT],
 	Eth1DataT Eth1Data[Eth1DataT],
-	ExecutionPayloadT any,
+	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT],
 	ExecutionPayloadHeaderT ExecutionPayloadHeader,
 	ForkDataT ForkData[ForkDataT],
 	SlashingInfoT any,
 	SlotDataT SlotData[AttestationDataT, SlashingInfoT],
 ](
 	cfg *Config,
+	graffiti string,
 	logger log.Logger,
 	chainSpec common.ChainSpec,
 	sb StorageBackend[BeaconStateT, DepositStoreT],
@@ -123,6 +127,16 @@ func NewService[
 	BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadT,
 	ExecutionPayloadHeaderT, ForkDataT, SlashingInfoT, SlotDataT,
 ] {
+	// Bound local payload retrieval by the slot duration, so that a slow
+	// execution client does not cost us the whole proposal slot.
+	if slotDuration := time.Duration(
+		chainSpec.TargetSecondsPerEth1Block(),
+	) * time.Second; slotDuration > 0 {
+		localPayloadBuilder = NewTimeoutPayloadBuilder(
+			localPayloadBuilder, slotDuration,
+		)
+	}
+
 	return &Service[
 		AttestationDataT, BeaconBlockT, BeaconBlockBodyT,
 		BeaconStateT, BlobSidecarsT, DepositT, DepositStoreT, Eth1DataT,
@@ -130,6 +144,7 @@ func NewService[
 		SlotDataT,
 	]{
 		cfg:                   cfg,
+		graffiti:              graffiti,
 		logger:                logger,
 		sb:                    sb,
 		chainSpec:             chainSpec,
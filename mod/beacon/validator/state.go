@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// PrepareStateForBuilding advances the state to the requested slot so that it
+// is ready to build a block for it. The requested slot must be ahead of the
+// state by at least one and at most maxSlotCatchup slots.
+func PrepareStateForBuilding[
+	BeaconStateT interface{ GetSlot() (math.Slot, error) },
+](
+	sp SlotProcessor[BeaconStateT],
+	st BeaconStateT,
+	requestedSlot math.Slot,
+	maxSlotCatchup uint64,
+) error {
+	stateSlot, err := st.GetSlot()
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case requestedSlot <= stateSlot:
+		return errors.Wrapf(
+			ErrRequestedSlotInPast,
+			"requested slot %d, state slot %d", requestedSlot, stateSlot,
+		)
+	case requestedSlot.Unwrap()-stateSlot.Unwrap() > maxSlotCatchup:
+		return errors.Wrapf(
+			ErrRequestedSlotTooFarAhead,
+			"requested slot %d, state slot %d, max catchup %d",
+			requestedSlot, stateSlot, maxSlotCatchup,
+		)
+	}
+
+	// Process the slots one at a time until the state is caught up.
+	for ; stateSlot < requestedSlot; stateSlot++ {
+		if _, err = sp.ProcessSlots(st, stateSlot+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package validator_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/beacon/validator"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+// slotState is a beacon state that only tracks its slot.
+type slotState struct {
+	slot math.Slot
+}
+
+func (s *slotState) GetSlot() (math.Slot, error) {
+	return s.slot, nil
+}
+
+// slotProcessor advances the slot of the state, recording every processed
+// slot.
+type slotProcessor struct {
+	processed []math.Slot
+}
+
+func (p *slotProcessor) ProcessSlots(
+	st *slotState, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	p.processed = append(p.processed, slot)
+	st.slot = slot
+	return nil, nil
+}
+
+func TestPrepareStateForBuilding(t *testing.T) {
+	tests := []struct {
+		name           string
+		stateSlot      math.Slot
+		requestedSlot  math.Slot
+		maxSlotCatchup uint64
+		wantProcessed  []math.Slot
+		wantErr        error
+	}{
+		{
+			name:           "Next slot",
+			stateSlot:      10,
+			requestedSlot:  11,
+			maxSlotCatchup: 1,
+			wantProcessed:  []math.Slot{11},
+		},
+		{
+			name:           "Gap of 2 slots",
+			stateSlot:      10,
+			requestedSlot:  12,
+			maxSlotCatchup: 3,
+			wantProcessed:  []math.Slot{11, 12},
+		},
+		{
+			name:           "Gap of 3 slots",
+			stateSlot:      10,
+			requestedSlot:  13,
+			maxSlotCatchup: 3,
+			wantProcessed:  []math.Slot{11, 12, 13},
+		},
+		{
+			name:           "Gap exceeding max catchup",
+			stateSlot:      10,
+			requestedSlot:  13,
+			maxSlotCatchup: 2,
+			wantErr:        validator.ErrRequestedSlotTooFarAhead,
+		},
+		{
+			name:           "Equal slot",
+			stateSlot:      10,
+			requestedSlot:  10,
+			maxSlotCatchup: 3,
+			wantErr:        validator.ErrRequestedSlotInPast,
+		},
+		{
+			name:           "Past slot",
+			stateSlot:      10,
+			requestedSlot:  9,
+			maxSlotCatchup: 3,
+			wantErr:        validator.ErrRequestedSlotInPast,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &slotState{slot: tt.stateSlot}
+			sp := &slotProcessor{}
+			err := validator.PrepareStateForBuilding(
+				sp, st, tt.requestedSlot, tt.maxSlotCatchup,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Empty(t, sp.processed)
+				require.Equal(t, tt.stateSlot, st.slot)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantProcessed, sp.processed)
+			require.Equal(t, tt.requestedSlot, st.slot)
+		})
+	}
+}
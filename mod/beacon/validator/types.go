@@ -48,6 +48,8 @@ type BeaconBlock[
 	) (T, error)
 	// GetSlot returns the slot of the beacon block.
 	GetSlot() math.Slot
+	// GetProposerIndex returns the proposer index of the beacon block.
+	GetProposerIndex() math.ValidatorIndex
 	// GetParentBlockRoot returns the parent block root of the beacon block.
 	GetParentBlockRoot() common.Root
 	// SetStateRoot sets the state root of the beacon block.
@@ -134,6 +136,14 @@ type Eth1Data[T any] interface {
 		depositCount math.U64,
 		blockHash common.ExecutionHash,
 	) T
+	// VerifyAgainst returns an error if the deposit count of the eth1 data
+	// is lower than the one of the given previous eth1 data.
+	VerifyAgainst(prev T) error
+}
+
+// ExecutionPayload represents the execution payload interface.
+type ExecutionPayload[T any] interface {
+	constraints.EngineType[T]
 }
 
 // ExecutionPayloadHeader represents the execution payload header interface.
@@ -153,6 +163,8 @@ type ForkData[T any] interface {
 		common.Version,
 		common.Root,
 	) T
+	// ComputeDomain computes the signing domain for the given domain type.
+	ComputeDomain(common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot computes the Randao signing root.
 	ComputeRandaoSigningRoot(
 		common.DomainType,
@@ -192,6 +204,14 @@ type SlotData[AttestationDataT, SlashingInfoT any] interface {
 	GetSlashingInfo() []SlashingInfoT
 }
 
+// SlotProcessor defines the interface for processing slots on the state.
+type SlotProcessor[BeaconStateT any] interface {
+	// ProcessSlots processes the slots up to the given slot.
+	ProcessSlots(
+		st BeaconStateT, slot math.Slot,
+	) (transition.ValidatorUpdates, error)
+}
+
 // StateProcessor defines the interface for processing the state.
 type StateProcessor[
 	BeaconBlockT any,
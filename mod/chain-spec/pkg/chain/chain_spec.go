@@ -102,6 +102,10 @@ type Spec[
 	// TargetSecondsPerEth1Block returns the target time between eth1 blocks.
 	TargetSecondsPerEth1Block() uint64
 
+	// MinBlockTime returns the minimum time in seconds between two execution
+	// payloads, or 0 if there is no minimum.
+	MinBlockTime() uint64
+
 	// Fork-related values.
 	// DenebPlusForkEpoch returns the epoch at which the Deneb+ fork takes
 	DenebPlusForkEpoch() EpochT
@@ -134,6 +138,16 @@ type Spec[
 	// slashing penalties.
 	ProportionalSlashingMultiplier() uint64
 
+	// Validator Cycle
+
+	// MinPerEpochChurnLimit returns the minimum number of validators that can
+	// enter or exit the validator set per epoch.
+	MinPerEpochChurnLimit() uint64
+
+	// ChurnLimitQuotient returns the divisor of the active validator count
+	// used to compute the churn limit.
+	ChurnLimitQuotient() uint64
+
 	// Capella Values
 
 	// MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
@@ -173,9 +187,21 @@ type Spec[
 	// epoch.
 	ActiveForkVersionForEpoch(epoch EpochT) uint32
 
+	// NextForkVersionAfterSlot returns the version and the activation slot
+	// of the next fork scheduled after the given slot, or false if no
+	// further fork is scheduled.
+	NextForkVersionAfterSlot(slot SlotT) (uint32, SlotT, bool)
+
 	// SlotToEpoch converts a slot number to an epoch number.
 	SlotToEpoch(slot SlotT) EpochT
 
+	// EpochAtSlot returns the epoch the given slot belongs to.
+	EpochAtSlot(slot SlotT) EpochT
+
+	// SlotsUntilEpochBoundary returns the number of slots from the given
+	// slot to the first slot of the next epoch.
+	SlotsUntilEpochBoundary(slot SlotT) uint64
+
 	// WithinDAPeriod checks if a given block slot is within the data
 	// availability period relative to the current slot.
 	WithinDAPeriod(block, current SlotT) bool
@@ -360,6 +386,14 @@ func (c chainSpec[
 	return c.Data.TargetSecondsPerEth1Block
 }
 
+// MinBlockTime returns the minimum time in seconds between two execution
+// payloads.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinBlockTime() uint64 {
+	return c.Data.MinBlockTime
+}
+
 // DenebPlusForEpoch returns the epoch of the Deneb+ fork.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -416,6 +450,20 @@ func (c chainSpec[
 	return c.Data.ProportionalSlashingMultiplier
 }
 
+// MinPerEpochChurnLimit returns the minimum churn limit per epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) MinPerEpochChurnLimit() uint64 {
+	return c.Data.MinPerEpochChurnLimit
+}
+
+// ChurnLimitQuotient returns the churn limit quotient.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) ChurnLimitQuotient() uint64 {
+	return c.Data.ChurnLimitQuotient
+}
+
 // MaxWithdrawalsPerPayload returns the maximum number of withdrawals per
 // payload.
 func (c chainSpec[
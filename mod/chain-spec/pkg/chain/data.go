@@ -88,6 +88,9 @@ type SpecData[
 	Eth1FollowDistance uint64 `mapstructure:"eth1-follow-distance"`
 	// TargetSecondsPerEth1Block is the target time between eth1 blocks.
 	TargetSecondsPerEth1Block uint64 `mapstructure:"target-seconds-per-eth1-block"`
+	// MinBlockTime is the minimum time in seconds between two execution
+	// payloads. A value of 0 disables the minimum.
+	MinBlockTime uint64 `mapstructure:"min-block-time"`
 
 	// Fork-related values.
 	//
@@ -117,6 +120,15 @@ type SpecData[
 	// base penalty.
 	ProportionalSlashingMultiplier uint64 `mapstructure:"proportional-slashing-multiplier"`
 
+	// Validator cycle constants.
+	//
+	// MinPerEpochChurnLimit is the minimum number of validators that can
+	// enter or exit the validator set per epoch.
+	MinPerEpochChurnLimit uint64 `mapstructure:"min-per-epoch-churn-limit"`
+	// ChurnLimitQuotient is the divisor of the active validator count used to
+	// compute the churn limit.
+	ChurnLimitQuotient uint64 `mapstructure:"churn-limit-quotient"`
+
 	// Capella Values
 	//
 	// MaxWithdrawalsPerPayload indicates the maximum number of withdrawal
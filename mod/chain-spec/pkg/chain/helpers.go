@@ -21,6 +21,8 @@
 package chain
 
 import (
+	"math"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -48,6 +50,37 @@ func (c chainSpec[
 	return version.Deneb
 }
 
+// NextForkVersionAfterSlot returns the version and the activation slot of the
+// first fork scheduled after the given slot. It returns false if no further
+// fork is scheduled.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) NextForkVersionAfterSlot(
+	slot SlotT,
+) (uint32, SlotT, bool) {
+	epoch := c.SlotToEpoch(slot)
+	active := c.ActiveForkVersionForEpoch(epoch)
+	for _, fork := range []struct {
+		version uint32
+		epoch   EpochT
+	}{
+		{version: version.DenebPlus, epoch: c.Data.DenebPlusForkEpoch},
+		{version: version.Electra, epoch: c.Data.ElectraForkEpoch},
+	} {
+		// A fork whose activation slot overflows is never reached.
+		if uint64(fork.epoch) > math.MaxUint64/c.SlotsPerEpoch() {
+			continue
+		}
+		if fork.version > active && fork.epoch > epoch {
+			//#nosec:G701 // realistically fine in practice.
+			return fork.version, SlotT(
+				uint64(fork.epoch) * c.SlotsPerEpoch(),
+			), true
+		}
+	}
+	return 0, 0, false
+}
+
 // SlotToEpoch converts a slot to an epoch.
 func (c chainSpec[
 	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
@@ -56,6 +89,21 @@ func (c chainSpec[
 	return EpochT(uint64(slot) / c.SlotsPerEpoch())
 }
 
+// EpochAtSlot returns the epoch the given slot belongs to.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) EpochAtSlot(slot SlotT) EpochT {
+	return c.SlotToEpoch(slot)
+}
+
+// SlotsUntilEpochBoundary returns the number of slots from the given slot to
+// the first slot of the next epoch, which is 1 for the last slot of an epoch.
+func (c chainSpec[
+	DomainTypeT, EpochT, ExecutionAddressT, SlotT, CometBFTConfigT,
+]) SlotsUntilEpochBoundary(slot SlotT) uint64 {
+	return c.SlotsPerEpoch() - uint64(slot)%c.SlotsPerEpoch()
+}
+
 // WithinDAPeriod checks if the block epoch is within
 // MIN_EPOCHS_FOR_BLOB_SIDECARS_REQUESTS
 // of the given current epoch.
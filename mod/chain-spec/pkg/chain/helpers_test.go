@@ -96,6 +96,53 @@ func TestSlotToEpoch(t *testing.T) {
 	}
 }
 
+// TestEpochAtSlot tests the EpochAtSlot method.
+func TestEpochAtSlot(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		slot     slot
+		expected epoch
+	}{
+		{name: "Epoch 0, Slot 0", slot: 0, expected: 0},
+		{name: "Epoch 0, Slot 31", slot: 31, expected: 0},
+		{name: "Epoch 1, Slot 32", slot: 32, expected: 1},
+		{name: "Epoch 2, Slot 95", slot: 95, expected: 2},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.EpochAtSlot(tt.slot)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
+// TestSlotsUntilEpochBoundary tests the SlotsUntilEpochBoundary method.
+func TestSlotsUntilEpochBoundary(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name     string
+		slot     slot
+		expected uint64
+	}{
+		{name: "First Slot of Epoch 0", slot: 0, expected: 32},
+		{name: "Middle of Epoch 0", slot: 10, expected: 22},
+		{name: "Last Slot of Epoch 0", slot: 31, expected: 1},
+		{name: "First Slot of Epoch 1", slot: 32, expected: 32},
+		{name: "Last Slot of Epoch 1", slot: 63, expected: 1},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := spec.SlotsUntilEpochBoundary(tt.slot)
+			require.Equal(t, tt.expected, result, "Test case : %s", tt.name)
+		})
+	}
+}
+
 // TestActiveForkVersionForSlot tests the ActiveForkVersionForSlot method.
 func TestActiveForkVersionForSlot(t *testing.T) {
 	// Define test cases
@@ -123,6 +170,52 @@ func TestActiveForkVersionForSlot(t *testing.T) {
 	}
 }
 
+// TestNextForkVersionAfterSlot tests the NextForkVersionAfterSlot method.
+func TestNextForkVersionAfterSlot(t *testing.T) {
+	// Define test cases
+	tests := []struct {
+		name            string
+		slot            slot
+		expectedVersion uint32
+		expectedSlot    slot
+		expectedOk      bool
+	}{
+		{
+			name:            "Before DenebPlus Fork",
+			slot:            0,
+			expectedVersion: version.DenebPlus,
+			expectedSlot:    288,
+			expectedOk:      true,
+		},
+		{
+			name:            "At DenebPlus Fork",
+			slot:            288,
+			expectedVersion: version.Electra,
+			expectedSlot:    320,
+			expectedOk:      true,
+		},
+		{
+			name:            "Just Before Electra Fork",
+			slot:            319,
+			expectedVersion: version.Electra,
+			expectedSlot:    320,
+			expectedOk:      true,
+		},
+		{name: "At Electra Fork", slot: 320, expectedOk: false},
+		{name: "After Electra Fork", slot: 640, expectedOk: false},
+	}
+
+	// Run test cases
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, s, ok := spec.NextForkVersionAfterSlot(tt.slot)
+			require.Equal(t, tt.expectedOk, ok, "Test case : %s", tt.name)
+			require.Equal(t, tt.expectedVersion, v, "Test case : %s", tt.name)
+			require.Equal(t, tt.expectedSlot, s, "Test case : %s", tt.name)
+		})
+	}
+}
+
 // TestWithinDAPeriod tests the WithinDAPeriod method.
 func TestWithinDAPeriod(t *testing.T) {
 	// Define test cases
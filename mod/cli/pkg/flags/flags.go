@@ -49,6 +49,8 @@ const (
 	RPCHealthCheckInteval   = engineRoot + "rpc-health-check-interval"
 	RPCJWTRefreshInterval   = engineRoot + "rpc-jwt-refresh-interval"
 	JWTSecretPath           = engineRoot + "jwt-secret-path"
+	FCURetries              = engineRoot + "fcu-retries"
+	FCURetryBaseDelay       = engineRoot + "fcu-retry-base-delay"
 
 	// KZG Config.
 	kzgRoot             = beaconKitRoot + "kzg."
@@ -61,6 +63,11 @@ const (
 	LogLevel   = loggerRoot + "log-level"
 	Style      = loggerRoot + "style"
 
+	// Deposit Store Config.
+	depositStoreRoot      = beaconKitRoot + "deposit-store."
+	DepositStoreDBBackend = depositStoreRoot + "db-backend"
+	DepositStoreCacheSize = depositStoreRoot + "cache-size"
+
 	// Block Store Service Config.
 	blockStoreServiceRoot               = beaconKitRoot + "block-store-service."
 	BlockStoreServiceEnabled            = blockStoreServiceRoot + "enabled"
@@ -101,6 +108,14 @@ func AddBeaconKitFlags(startCmd *cobra.Command) {
 		defaultCfg.Engine.RPCJWTRefreshInterval,
 		"rpc jwt refresh interval",
 	)
+	startCmd.Flags().Uint64(
+		FCURetries, defaultCfg.Engine.FCURetries, "forkchoice update retries",
+	)
+	startCmd.Flags().Duration(
+		FCURetryBaseDelay,
+		defaultCfg.Engine.FCURetryBaseDelay,
+		"forkchoice update retry base delay",
+	)
 	startCmd.Flags().String(
 		SuggestedFeeRecipient,
 		defaultCfg.PayloadBuilder.SuggestedFeeRecipient.Hex(),
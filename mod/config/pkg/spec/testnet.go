@@ -112,6 +112,9 @@ func BaseSpec() chain.SpecData[
 		MaxDepositsPerBlock: 16,
 		// Slashing
 		ProportionalSlashingMultiplier: 1,
+		// Validator cycle.
+		MinPerEpochChurnLimit: 4,
+		ChurnLimitQuotient:    1 << 16,
 		// Capella values.
 		MaxWithdrawalsPerPayload:         16,
 		MaxValidatorsPerWithdrawalsSweep: 1 << 14,
@@ -42,6 +42,12 @@ rpc-startup-check-interval = "{{ .BeaconKit.Engine.RPCStartupCheckInterval }}"
 # Interval for the JWT refresh.
 rpc-jwt-refresh-interval = "{{ .BeaconKit.Engine.RPCJWTRefreshInterval }}"
 
+# Number of retries of a forkchoice update on execution client connection errors.
+fcu-retries = "{{ .BeaconKit.Engine.FCURetries }}"
+
+# Delay before the first forkchoice update retry, doubled on every retry.
+fcu-retry-base-delay = "{{ .BeaconKit.Engine.FCURetryBaseDelay }}"
+
 # Path to the execution client JWT-secret
 jwt-secret-path = "{{.BeaconKit.Engine.JWTSecretPath}}"
 
@@ -79,12 +85,32 @@ payload-timeout = "{{ .BeaconKit.PayloadBuilder.PayloadTimeout }}"
 
 [beacon-kit.validator]
 # Graffiti string that will be included in the graffiti field of the beacon block.
+# The {slot}, {version} and {proposer} tokens are replaced with the values of the
+# block, and the result is truncated to 32 bytes.
 graffiti = "{{.BeaconKit.Validator.Graffiti}}"
 
 # EnableOptimisticPayloadBuilds enables building the next block's payload optimistically in
 # process-proposal to allow for the execution client to have more time to assemble the block.
 enable-optimistic-payload-builds = "{{.BeaconKit.Validator.EnableOptimisticPayloadBuilds}}"
 
+# MaxSlotCatchup is the maximum number of slots the state is advanced by before building
+# a block, e.g. to catch up after missed slots.
+max-slot-catchup = "{{.BeaconKit.Validator.MaxSlotCatchup}}"
+
+# EnableEmptyBlockFallback proposes a block with an empty execution payload instead of
+# missing the slot when the payload cannot be retrieved from the execution client.
+# This changes proposer behavior and should only be enabled deliberately.
+enable-empty-block-fallback = "{{.BeaconKit.Validator.EnableEmptyBlockFallback}}"
+
+[beacon-kit.deposit-store]
+# Database backend of the deposit store, one of "pebbledb", "goleveldb" or
+# "memdb". The "memdb" backend is not persisted and only meant for testing.
+db-backend = "pebbledb"
+
+# Number of deposit ranges kept in memory by the caching deposit store, for
+# nodes that opt into it.
+cache-size = 256
+
 [beacon-kit.block-store-service]
 # Enabled determines if the block store service is enabled.
 enabled = "{{ .BeaconKit.BlockStoreService.Enabled }}"
@@ -101,4 +127,8 @@ address = "{{ .BeaconKit.NodeAPI.Address }}"
 
 # Logging determines if the node API logging is enabled.
 logging = "{{ .BeaconKit.NodeAPI.Logging }}"
+
+# QueryContextCacheSize is the number of query contexts cached by the node API.
+# A size of 0 disables the cache.
+query-context-cache-size = "{{ .BeaconKit.NodeAPI.QueryContextCacheSize }}"
 `
@@ -236,3 +236,15 @@ func (b *BeaconBlock) GetHeader() *BeaconBlockHeader {
 func (b *BeaconBlock) GetTimestamp() math.U64 {
 	return b.Body.ExecutionPayload.Timestamp
 }
+
+// GetExecutionNumber retrieves the block number of the BeaconBlock from
+// the ExecutionPayload.
+func (b *BeaconBlock) GetExecutionNumber() math.U64 {
+	return b.Body.ExecutionPayload.Number
+}
+
+// GetNumBlobKzgCommitments retrieves the number of blob KZG commitments in
+// the body of the BeaconBlock.
+func (b *BeaconBlock) GetNumBlobKzgCommitments() int {
+	return len(b.Body.BlobKzgCommitments)
+}
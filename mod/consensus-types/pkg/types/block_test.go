@@ -102,6 +102,7 @@ func TestBeaconBlock(t *testing.T) {
 
 	require.NotNil(t, block.Body)
 	require.Equal(t, math.U64(10), block.GetTimestamp())
+	require.Equal(t, 1, block.GetNumBlobKzgCommitments())
 	require.Equal(t, version.Deneb, block.Version())
 	require.False(t, block.IsNil())
 
@@ -26,6 +26,8 @@
 package types
 
 import (
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
@@ -257,11 +259,11 @@ func (
 }
 
 // SetBlobKzgCommitments sets the BlobKzgCommitments of the
-// BeaconBlockBody.
+// BeaconBlockBody. The commitments are copied, as in SetDeposits.
 func (b *BeaconBlockBody) SetBlobKzgCommitments(
 	commitments eip4844.KZGCommitments[common.ExecutionHash],
 ) {
-	b.BlobKzgCommitments = commitments
+	b.BlobKzgCommitments = slices.Clone(commitments)
 }
 
 // SetEth1Data sets the Eth1Data of the BeaconBlockBody.
@@ -337,7 +339,8 @@ func (b *BeaconBlockBody) GetDeposits() []*Deposit {
 	return b.Deposits
 }
 
-// SetDeposits sets the Deposits of the BeaconBlockBody.
+// SetDeposits sets the Deposits of the BeaconBlockBody. The slice is copied so
+// that later changes to it by the caller do not alter the body.
 func (b *BeaconBlockBody) SetDeposits(deposits []*Deposit) {
-	b.Deposits = deposits
+	b.Deposits = slices.Clone(deposits)
 }
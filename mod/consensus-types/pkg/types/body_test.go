@@ -119,6 +119,28 @@ func TestBeaconBlockBody_SetDeposits(t *testing.T) {
 	require.Equal(t, deposits, body.GetDeposits())
 }
 
+func TestBeaconBlockBody_SetDepositsCopies(t *testing.T) {
+	body := types.BeaconBlockBody{}
+	deposit := &types.Deposit{Index: 1}
+	deposits := []*types.Deposit{deposit}
+	body.SetDeposits(deposits)
+
+	deposits[0] = &types.Deposit{Index: 2}
+	require.Len(t, body.GetDeposits(), 1)
+	require.Same(t, deposit, body.GetDeposits()[0])
+}
+
+func TestBeaconBlockBody_SetBlobKzgCommitmentsCopies(t *testing.T) {
+	body := types.BeaconBlockBody{}
+	commitments := eip4844.KZGCommitments[common.ExecutionHash]{{1}}
+	body.SetBlobKzgCommitments(commitments)
+
+	commitments[0] = eip4844.KZGCommitment{2}
+	require.Equal(
+		t, eip4844.KZGCommitment{1}, body.GetBlobKzgCommitments()[0],
+	)
+}
+
 func TestBeaconBlockBody_MarshalSSZ(t *testing.T) {
 	body := types.BeaconBlockBody{
 		RandaoReveal:       [96]byte{1, 2, 3},
@@ -21,6 +21,7 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -89,10 +90,10 @@ func (d *Deposit) New(
 	)
 }
 
-// VerifySignature verifies the deposit data and signature.
+// VerifySignature verifies the deposit data and signature over the given
+// signing domain.
 func (d *Deposit) VerifySignature(
-	forkData *ForkData,
-	domainType common.DomainType,
+	domain common.Domain,
 	signatureVerificationFn func(
 		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
 	) error,
@@ -101,10 +102,59 @@ func (d *Deposit) VerifySignature(
 		Pubkey:      d.Pubkey,
 		Credentials: d.Credentials,
 		Amount:      d.Amount,
-	}).VerifyCreateValidator(
-		forkData, d.Signature,
-		domainType, signatureVerificationFn,
+	}).verifySignature(domain, d.Signature, signatureVerificationFn)
+}
+
+// BatchVerifyDepositSignatures verifies the signatures of all deposits with a
+// single call to batchVerifyFn. If the batch fails, every deposit is verified
+// on its own to report the index of the first invalid one.
+func BatchVerifyDepositSignatures(
+	deposits []*Deposit,
+	forkData *ForkData,
+	domainType common.DomainType,
+	batchVerifyFn func(
+		pubkeys []crypto.BLSPubkey,
+		messages [][]byte,
+		signatures []crypto.BLSSignature,
+	) error,
+) error {
+	if len(deposits) == 0 {
+		return nil
+	}
+
+	var (
+		domain     = forkData.ComputeDomain(domainType)
+		pubkeys    = make([]crypto.BLSPubkey, len(deposits))
+		messages   = make([][]byte, len(deposits))
+		signatures = make([]crypto.BLSSignature, len(deposits))
 	)
+	for i, d := range deposits {
+		signingRoot := ComputeSigningRoot(&DepositMessage{
+			Pubkey:      d.Pubkey,
+			Credentials: d.Credentials,
+			Amount:      d.Amount,
+		}, domain)
+		pubkeys[i] = d.Pubkey
+		messages[i] = signingRoot[:]
+		signatures[i] = d.Signature
+	}
+
+	batchErr := batchVerifyFn(pubkeys, messages, signatures)
+	if batchErr == nil {
+		return nil
+	}
+
+	// Fall back to verifying one by one to pinpoint the failing deposit.
+	for i := range deposits {
+		if err := batchVerifyFn(
+			pubkeys[i:i+1], messages[i:i+1], signatures[i:i+1],
+		); err != nil {
+			return errors.Join(
+				err, errors.Wrapf(ErrDepositMessage, "deposit %d", i),
+			)
+		}
+	}
+	return errors.Join(batchErr, ErrDepositMessage)
 }
 
 /* -------------------------------------------------------------------------- */
@@ -130,8 +130,21 @@ func (dm *DepositMessage) VerifyCreateValidator(
 		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
 	) error,
 ) error {
-	signingRoot := ComputeSigningRoot(
-		dm, forkData.ComputeDomain(domainType))
+	return dm.verifySignature(
+		forkData.ComputeDomain(domainType), signature, signatureVerificationFn,
+	)
+}
+
+// verifySignature verifies the signature over the deposit message for the
+// given signing domain.
+func (dm *DepositMessage) verifySignature(
+	domain common.Domain,
+	signature crypto.BLSSignature,
+	signatureVerificationFn func(
+		pubkey crypto.BLSPubkey, message []byte, signature crypto.BLSSignature,
+	) error,
+) error {
+	signingRoot := ComputeSigningRoot(dm, domain)
 	if err := signatureVerificationFn(
 		dm.Pubkey, signingRoot[:], signature,
 	); err != nil {
@@ -21,6 +21,7 @@
 package types_test
 
 import (
+	"errors"
 	"io"
 	"testing"
 
@@ -136,12 +137,92 @@ func TestDeposit_VerifySignature(t *testing.T) {
 		return nil
 	}
 
-	errVerify := deposit.VerifySignature(forkData, common.DomainType{
-		0x01, 0x00, 0x00, 0x00,
-	}, signatureVerificationFn)
+	errVerify := deposit.VerifySignature(forkData.ComputeDomain(
+		common.DomainType{0x01, 0x00, 0x00, 0x00},
+	), signatureVerificationFn)
 	require.NoError(t, errVerify)
 }
 
+func TestBatchVerifyDepositSignatures(t *testing.T) {
+	var (
+		forkData = &types.ForkData{
+			CurrentVersion:        common.Version{0x00, 0x00, 0x00, 0x04},
+			GenesisValidatorsRoot: common.Root{},
+		}
+		domainType = common.DomainType{0x03, 0x00, 0x00, 0x00}
+		badSig     = crypto.BLSSignature{0xff}
+		errBadSig  = errors.New("bad signature")
+	)
+	newDeposits := func(n, bad int) []*types.Deposit {
+		deposits := make([]*types.Deposit, n)
+		for i := range deposits {
+			deposits[i] = generateValidDeposit()
+			deposits[i].Index = uint64(i)
+		}
+		if bad >= 0 {
+			deposits[bad].Signature = badSig
+		}
+		return deposits
+	}
+
+	tests := []struct {
+		name      string
+		deposits  []*types.Deposit
+		wantErr   error
+		wantIndex string
+		wantCalls int
+	}{
+		{
+			name:      "No deposits",
+			wantCalls: 0,
+		},
+		{
+			name:      "All valid",
+			deposits:  newDeposits(4, -1),
+			wantCalls: 1,
+		},
+		{
+			name:      "Invalid deposit is pinpointed",
+			deposits:  newDeposits(4, 2),
+			wantErr:   types.ErrDepositMessage,
+			wantIndex: "deposit 2",
+			wantCalls: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			batchVerifyFn := func(
+				pubkeys []crypto.BLSPubkey,
+				messages [][]byte,
+				signatures []crypto.BLSSignature,
+			) error {
+				calls++
+				require.Len(t, messages, len(pubkeys))
+				require.Len(t, signatures, len(pubkeys))
+				for _, sig := range signatures {
+					if sig == badSig {
+						return errBadSig
+					}
+				}
+				return nil
+			}
+
+			err := types.BatchVerifyDepositSignatures(
+				tt.deposits, forkData, domainType, batchVerifyFn,
+			)
+			require.Equal(t, tt.wantCalls, calls)
+			if tt.wantErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.wantErr)
+			require.ErrorIs(t, err, errBadSig)
+			require.ErrorContains(t, err, tt.wantIndex)
+		})
+	}
+}
+
 func TestDeposit_Getters(t *testing.T) {
 	deposit := generateValidDeposit()
 
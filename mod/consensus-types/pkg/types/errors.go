@@ -38,4 +38,17 @@ var (
 
 	// ErrNilPayloadHeader is an error for when the payload header is nil.
 	ErrNilPayloadHeader = errors.New("nil payload header")
+
+	// ErrNoGenesisDeposits is an error for when the genesis has no deposits.
+	ErrNoGenesisDeposits = errors.New("genesis has no deposits")
+
+	// ErrZeroGenesisBlockHash is an error for when the genesis execution
+	// payload header has a zero block hash.
+	ErrZeroGenesisBlockHash = errors.New(
+		"genesis execution payload header has zero block hash",
+	)
+
+	// ErrDepositCountRegression is an error for when the deposit count of an
+	// Eth1Data is lower than the one it follows.
+	ErrDepositCountRegression = errors.New("eth1 data deposit count regression")
 )
@@ -21,6 +21,7 @@
 package types
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -140,3 +141,17 @@ func (e *Eth1Data) GetTree() (*fastssz.Node, error) {
 func (e *Eth1Data) GetDepositCount() math.U64 {
 	return e.DepositCount
 }
+
+// VerifyAgainst ensures the deposit count of the Eth1Data does not decrease
+// relative to the given previous Eth1Data, as deposits are never removed from
+// the deposit contract.
+func (e *Eth1Data) VerifyAgainst(prev *Eth1Data) error {
+	if e.DepositCount < prev.DepositCount {
+		return errors.Wrapf(
+			ErrDepositCountRegression,
+			"deposit count %d, previous %d",
+			e.DepositCount, prev.DepositCount,
+		)
+	}
+	return nil
+}
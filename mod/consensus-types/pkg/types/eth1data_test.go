@@ -26,6 +26,7 @@ import (
 
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -106,3 +107,39 @@ func TestEth1Data_GetDepositCount(t *testing.T) {
 
 	require.Equal(t, uint64(10), count.Unwrap())
 }
+
+func TestEth1Data_VerifyAgainst(t *testing.T) {
+	prev := &types.Eth1Data{DepositCount: 10}
+
+	tests := []struct {
+		name         string
+		depositCount math.U64
+		wantErr      error
+	}{
+		{
+			name:         "Increasing deposit count",
+			depositCount: 11,
+		},
+		{
+			name:         "Unchanged deposit count",
+			depositCount: 10,
+		},
+		{
+			name:         "Decreasing deposit count",
+			depositCount: 9,
+			wantErr:      types.ErrDepositCountRegression,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eth1Data := &types.Eth1Data{DepositCount: tt.depositCount}
+			err := eth1Data.VerifyAgainst(prev)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
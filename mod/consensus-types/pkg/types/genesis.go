@@ -25,6 +25,7 @@ import (
 	"math/big"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/errors"
 	byteslib "github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
@@ -47,6 +48,8 @@ type Genesis[
 	DepositT any,
 	ExecutionPayloadHeaderT interface {
 		NewFromJSON([]byte, uint32) (ExecutionPayloadHeaderT, error)
+		IsNil() bool
+		GetBlockHash() common.ExecutionHash
 	},
 ] struct {
 	// ForkVersion is the fork version of the genesis slot.
@@ -61,6 +64,20 @@ type Genesis[
 	ExecutionPayloadHeader ExecutionPayloadHeaderT `json:"execution_payload_header"`
 }
 
+// New creates a new genesis with the given fork version, deposits and
+// execution payload header.
+func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) New(
+	forkVersion common.Version,
+	deposits []DepositT,
+	executionPayloadHeader ExecutionPayloadHeaderT,
+) *Genesis[DepositT, ExecutionPayloadHeaderT] {
+	return &Genesis[DepositT, ExecutionPayloadHeaderT]{
+		ForkVersion:            forkVersion,
+		Deposits:               deposits,
+		ExecutionPayloadHeader: executionPayloadHeader,
+	}
+}
+
 // GetForkVersion returns the fork version in the genesis.
 func (g *Genesis[
 	DepositT, ExecutionPayloadHeaderT,
@@ -80,6 +97,27 @@ func (g *Genesis[
 	return g.ExecutionPayloadHeader
 }
 
+// Validate checks that the genesis has deposits, a known fork version and an
+// execution payload header with a non-zero block hash.
+func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) Validate() error {
+	if len(g.Deposits) == 0 {
+		return ErrNoGenesisDeposits
+	}
+
+	if v := version.ToUint32(g.ForkVersion); v > version.Electra {
+		return errors.Wrapf(ErrForkVersionNotSupported, "genesis: %d", v)
+	}
+
+	if g.ExecutionPayloadHeader.IsNil() {
+		return ErrNilPayloadHeader
+	}
+
+	if g.ExecutionPayloadHeader.GetBlockHash() == (common.ExecutionHash{}) {
+		return ErrZeroGenesisBlockHash
+	}
+	return nil
+}
+
 // UnmarshalJSON for Genesis.
 func (g *Genesis[DepositT, ExecutionPayloadHeaderT]) UnmarshalJSON(
 	data []byte,
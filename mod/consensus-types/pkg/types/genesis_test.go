@@ -27,6 +27,8 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	"github.com/stretchr/testify/require"
@@ -95,6 +97,92 @@ func TestGenesisGetExecutionPayloadHeader(t *testing.T) {
 	require.NotNil(t, header)
 }
 
+func TestGenesisNewJSONRoundTrip(t *testing.T) {
+	header, err := types.DefaultGenesisExecutionPayloadHeaderDeneb()
+	require.NoError(t, err)
+	deposits := []*types.Deposit{
+		types.NewDeposit(
+			crypto.BLSPubkey{0x01}, types.WithdrawalCredentials{0x02},
+			math.Gwei(32e9), crypto.BLSSignature{}, 0,
+		),
+	}
+	forkVersion := version.FromUint32[common.Version](version.Deneb)
+
+	g := (&types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader]{}).
+		New(forkVersion, deposits, header)
+	bz, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	got := &types.Genesis[*types.Deposit, *types.ExecutionPayloadHeader]{}
+	require.NoError(t, got.UnmarshalJSON(bz))
+	require.Equal(t, forkVersion, got.GetForkVersion())
+	require.Equal(t, deposits, got.GetDeposits())
+	require.Equal(
+		t, header.GetBlockHash(), got.GetExecutionPayloadHeader().GetBlockHash(),
+	)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	type genesis = types.Genesis[
+		*types.Deposit, *types.ExecutionPayloadHeader,
+	]
+	deposits := []*types.Deposit{
+		types.NewDeposit(
+			crypto.BLSPubkey{0x01}, types.WithdrawalCredentials{0x02},
+			math.Gwei(32e9), crypto.BLSSignature{}, 0,
+		),
+	}
+	tests := []struct {
+		name    string
+		modify  func(*genesis)
+		wantErr error
+	}{
+		{
+			name: "Valid genesis",
+		},
+		{
+			name: "No deposits",
+			modify: func(g *genesis) {
+				g.Deposits = nil
+			},
+			wantErr: types.ErrNoGenesisDeposits,
+		},
+		{
+			name: "Unknown fork version",
+			modify: func(g *genesis) {
+				g.ForkVersion = version.FromUint32[common.Version](
+					version.Electra + 1,
+				)
+			},
+			wantErr: types.ErrForkVersionNotSupported,
+		},
+		{
+			name: "Nil execution payload header",
+			modify: func(g *genesis) {
+				g.ExecutionPayloadHeader = nil
+			},
+			wantErr: types.ErrNilPayloadHeader,
+		},
+		{
+			name: "Zero block hash",
+			modify: func(g *genesis) {
+				g.ExecutionPayloadHeader.BlockHash = common.ExecutionHash{}
+			},
+			wantErr: types.ErrZeroGenesisBlockHash,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := types.DefaultGenesisDeneb()
+			g.Deposits = deposits
+			if tt.modify != nil {
+				tt.modify(g)
+			}
+			require.ErrorIs(t, g.Validate(), tt.wantErr)
+		})
+	}
+}
+
 func TestDefaultGenesisDenebPanics(t *testing.T) {
 	require.NotPanics(t, func() {
 		types.DefaultGenesisDeneb()
@@ -174,6 +174,19 @@ func (b *BeaconBlockHeader) GetTree() (*fastssz.Node, error) {
 /*                            Getters and Setters                             */
 /* -------------------------------------------------------------------------- */
 
+// Equals returns true if the BeaconBlockHeader is equal to the other, comparing
+// its fields rather than its SSZ encoding.
+func (b *BeaconBlockHeader) Equals(other *BeaconBlockHeader) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	return b.Slot == other.Slot &&
+		b.ProposerIndex == other.ProposerIndex &&
+		b.ParentBlockRoot == other.ParentBlockRoot &&
+		b.StateRoot == other.StateRoot &&
+		b.BodyRoot == other.BodyRoot
+}
+
 // GetSlot retrieves the slot of the BeaconBlockHeader.
 func (b *BeaconBlockHeader) GetSlot() math.Slot {
 	return b.Slot
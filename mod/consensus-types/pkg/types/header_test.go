@@ -110,6 +110,65 @@ func TestBeaconBlockHeader_SetStateRoot(t *testing.T) {
 	require.Equal(t, newStateRoot, header.GetStateRoot())
 }
 
+func TestBeaconBlockHeader_Equals(t *testing.T) {
+	newHeader := func() *types.BeaconBlockHeader {
+		return types.NewBeaconBlockHeader(
+			math.Slot(100),
+			math.ValidatorIndex(200),
+			common.Root{1},
+			common.Root{2},
+			common.Root{3},
+		)
+	}
+	tests := []struct {
+		name   string
+		modify func(*types.BeaconBlockHeader)
+		want   bool
+	}{
+		{name: "Equal", modify: func(*types.BeaconBlockHeader) {}, want: true},
+		{
+			name:   "Different slot",
+			modify: func(h *types.BeaconBlockHeader) { h.SetSlot(101) },
+		},
+		{
+			name: "Different proposer index",
+			modify: func(h *types.BeaconBlockHeader) {
+				h.SetProposerIndex(201)
+			},
+		},
+		{
+			name: "Different parent block root",
+			modify: func(h *types.BeaconBlockHeader) {
+				h.SetParentBlockRoot(common.Root{4})
+			},
+		},
+		{
+			name: "Different state root",
+			modify: func(h *types.BeaconBlockHeader) {
+				h.SetStateRoot(common.Root{4})
+			},
+		},
+		{
+			name: "Different body root",
+			modify: func(h *types.BeaconBlockHeader) {
+				h.SetBodyRoot(common.Root{4})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header, other := newHeader(), newHeader()
+			tt.modify(other)
+			require.Equal(t, tt.want, header.Equals(other))
+			require.Equal(t, tt.want, other.Equals(header))
+		})
+	}
+
+	var nilHeader *types.BeaconBlockHeader
+	require.False(t, newHeader().Equals(nil))
+	require.True(t, nilHeader.Equals(nil))
+}
+
 func TestBeaconBlockHeader_New(t *testing.T) {
 	slot := math.Slot(100)
 	proposerIndex := math.ValidatorIndex(200)
@@ -568,6 +568,13 @@ func (p *ExecutionPayload) ToHeader(
 		txsRoot = p.GetTransactions().HashTreeRoot()
 	}
 
+	withdrawalsRoot, err := engineprimitives.WithdrawalsRoot(
+		p.GetWithdrawals(), constants.MaxWithdrawalsPerPayload,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	switch p.Version() {
 	case version.Deneb, version.DenebPlus:
 		return &ExecutionPayloadHeader{
@@ -585,7 +592,7 @@ func (p *ExecutionPayload) ToHeader(
 			BaseFeePerGas:    p.GetBaseFeePerGas(),
 			BlockHash:        p.BlockHash,
 			TransactionsRoot: txsRoot,
-			WithdrawalsRoot:  p.GetWithdrawals().HashTreeRoot(),
+			WithdrawalsRoot:  withdrawalsRoot,
 			BlobGasUsed:      p.GetBlobGasUsed(),
 			ExcessBlobGas:    p.GetExcessBlobGas(),
 		}, nil
@@ -28,6 +28,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
@@ -236,6 +237,21 @@ func TestExecutionPayload_ToHeader(t *testing.T) {
 	// require.Equal(t, htrPayload, htrHeader)
 }
 
+func TestExecutionPayload_ToHeaderWithdrawalsExceedLimit(t *testing.T) {
+	payload := generateExecutionPayload()
+	payload.Withdrawals = make(
+		engineprimitives.Withdrawals, constants.MaxWithdrawalsPerPayload+1,
+	)
+	for i := range payload.Withdrawals {
+		payload.Withdrawals[i] = &engineprimitives.Withdrawal{}
+	}
+
+	_, err := payload.ToHeader(
+		constants.MaxWithdrawalsPerPayload, uint64(80087),
+	)
+	require.ErrorIs(t, err, engineprimitives.ErrWithdrawalsExceedLimit)
+}
+
 func TestExecutionPayload_UnmarshalJSON_Error(t *testing.T) {
 	original := generateExecutionPayload()
 	validJSON, err := original.MarshalJSON()
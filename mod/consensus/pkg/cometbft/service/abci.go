@@ -35,6 +35,7 @@ import (
 	errorsmod "github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	math "github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -247,11 +248,61 @@ func (s *Service[LoggerT]) ProcessProposal(
 	// again in a subsequent round. However, we only want to do this after we've
 	// processed the first block, as we want to avoid overwriting the finalizeState
 	// after state changes during InitChain.
-	s.processProposalState = s.resetState()
 	if req.Height > s.initialHeight {
 		s.finalizeBlockState = s.resetState()
 	}
 
+	return s.processProposal(req), nil
+}
+
+// ProcessProposalBatch processes the given proposals sequentially and returns
+// their responses in the same order as the requests.
+//
+// All proposals must be for the same height, e.g. competing proposals of
+// several rounds, since the state only advances on FinalizeBlock and Commit.
+// Each proposal is verified against a fresh branch of the committed state, so
+// the outcome of one proposal never affects the ones after it. Only the reset
+// of the finalize block state is shared across the batch.
+func (s *Service[LoggerT]) ProcessProposalBatch(
+	_ context.Context,
+	reqs []*cmtabci.ProcessProposalRequest,
+) ([]*cmtabci.ProcessProposalResponse, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	// Validate every height before processing anything, so that a malformed
+	// batch is rejected as a whole.
+	height := reqs[0].Height
+	for _, req := range reqs {
+		if req.Height < 1 || req.Height != height {
+			return nil, fmt.Errorf(
+				"processProposalBatch at height %v, batch height %v: %w",
+				req.Height,
+				height,
+				errInvalidHeight,
+			)
+		}
+	}
+
+	// See ProcessProposal for why the finalize block state is reset.
+	if height > s.initialHeight {
+		s.finalizeBlockState = s.resetState()
+	}
+
+	resps := make([]*cmtabci.ProcessProposalResponse, len(reqs))
+	for i, req := range reqs {
+		resps[i] = s.processProposal(req)
+	}
+	return resps, nil
+}
+
+// processProposal verifies a single proposal against a fresh branch of the
+// committed state. A proposal the middleware fails to process is rejected.
+func (s *Service[LoggerT]) processProposal(
+	req *cmtabci.ProcessProposalRequest,
+) *cmtabci.ProcessProposalResponse {
+	s.processProposalState = s.resetState()
 	s.processProposalState.SetContext(
 		s.getContextForProposal(
 			s.processProposalState.Context(),
@@ -277,10 +328,10 @@ func (s *Service[LoggerT]) ProcessProposal(
 		)
 		return &cmtabci.ProcessProposalResponse{
 			Status: cmtabci.PROCESS_PROPOSAL_STATUS_REJECT,
-		}, nil
+		}
 	}
 
-	return resp, nil
+	return resp
 }
 
 func (s *Service[LoggerT]) internalFinalizeBlock(
@@ -389,6 +440,33 @@ func (s *Service[_]) FinalizeBlock(
 	return res, err
 }
 
+// SimulateFinalizeBlock runs the finalize block path for the given request
+// against a branch of the current state and returns the resulting validator
+// updates. All writes are discarded and the block is marked as a simulation,
+// so that it is neither persisted nor sent to the execution client in a
+// forkchoice update.
+//
+// It must not be called concurrently with the ABCI methods of the service.
+func (s *Service[_]) SimulateFinalizeBlock(
+	_ context.Context,
+	req *cmtabci.FinalizeBlockRequest,
+) (transition.ValidatorUpdates, error) {
+	if err := s.validateFinalizeBlockHeight(req); err != nil {
+		return nil, err
+	}
+
+	// Branch off the finalize block state if there is one, so that state
+	// changes made during InitChain are visible, as done for proposals.
+	var ctx sdk.Context
+	if s.finalizeBlockState != nil {
+		ctx, _ = s.finalizeBlockState.Context().CacheContext()
+	} else {
+		ctx = s.resetState().Context()
+	}
+
+	return s.Middleware.FinalizeBlock(transition.WithSimulation(ctx), req)
+}
+
 // Commit implements the ABCI interface. It will commit all state that exists in
 // the deliver state's multi-store and includes the resulting commit ID in the
 // returned cmtabci.ResponseCommit. Commit will set the check state based on the
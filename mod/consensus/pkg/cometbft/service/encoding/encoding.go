@@ -21,7 +21,10 @@
 package encoding
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // ExtractBlobsAndBlockFromRequest extracts the blobs and block from an ABCI
@@ -31,6 +34,7 @@ func ExtractBlobsAndBlockFromRequest[
 	BlobSidecarsT interface {
 		constraints.SSZUnmarshaler
 		Empty() BlobSidecarsT
+		Len() int
 	},
 ](
 	req ABCIRequest,
@@ -64,11 +68,11 @@ func ExtractBlobsAndBlockFromRequest[
 		return blk, blobs, err
 	}
 
-	return blk, blobs, nil
+	return blk, blobs, VerifyBlobSidecarsCount(blk, blobs)
 }
 
 // UnmarshalBeaconBlockFromABCIRequest extracts a beacon block from an ABCI
-// request.
+// request, ensuring its slot matches the height of the request.
 func UnmarshalBeaconBlockFromABCIRequest[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 ](
@@ -81,25 +85,83 @@ func UnmarshalBeaconBlockFromABCIRequest[
 		return blk, ErrNilABCIRequest
 	}
 
-	txs := req.GetTxs()
-	lenTxs := uint(len(txs))
+	blkBz, err := beaconBlockBzFromABCIRequest(req, bzIndex)
+	if err != nil {
+		return blk, err
+	}
 
-	// Ensure there are transactions in the request and that the request is
-	// valid.
-	if txs == nil || lenTxs == 0 {
-		return blk, ErrNoBeaconBlockInRequest
+	blk, err = blk.NewFromSSZ(blkBz, forkVersion)
+	if err != nil {
+		return blk, err
 	}
-	if bzIndex >= lenTxs {
-		return blk, ErrBzIndexOutOfBounds
+
+	// Each height of the chain holds the beacon block of the same slot.
+	//#nosec:G701 // the height is validated to be positive by the service.
+	if slot := math.Slot(req.GetHeight()); blk.GetSlot() != slot {
+		return blk, errors.Wrapf(
+			ErrBlockSlotMismatch,
+			"block slot %d, request height %d",
+			blk.GetSlot(), slot,
+		)
 	}
+	return blk, nil
+}
 
-	// Extract the beacon block from the ABCI request.
-	blkBz := txs[bzIndex]
-	if blkBz == nil {
-		return blk, ErrNilBeaconBlockInRequest
+// UnmarshalBeaconBlocksFromABCIRequest extracts count consecutive beacon
+// blocks from an ABCI request, starting at startIndex. The first malformed
+// block is reported along with its index.
+//
+// NOTE: Unlike UnmarshalBeaconBlockFromABCIRequest, the slots of the blocks
+// are not checked against the height of the request.
+func UnmarshalBeaconBlocksFromABCIRequest[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+](
+	req ABCIRequest,
+	startIndex uint,
+	count uint,
+	forkVersion uint32,
+) ([]BeaconBlockT, error) {
+	if req == nil {
+		return nil, ErrNilABCIRequest
 	}
 
-	return blk.NewFromSSZ(blkBz, forkVersion)
+	blks := make([]BeaconBlockT, 0, count)
+	for i := startIndex; i < startIndex+count; i++ {
+		blkBz, err := beaconBlockBzFromABCIRequest(req, i)
+		if err != nil {
+			return nil, errors.Wrapf(err, "beacon block at index %d", i)
+		}
+
+		var blk BeaconBlockT
+		if blk, err = blk.NewFromSSZ(blkBz, forkVersion); err != nil {
+			return nil, errors.Wrapf(err, "beacon block at index %d", i)
+		}
+		blks = append(blks, blk)
+	}
+	return blks, nil
+}
+
+// UnmarshalBeaconBlockFromABCIRequestWithSpec extracts a beacon block from an
+// ABCI request, decoding it with the fork version the chain spec activates at
+// the slot of the request height.
+func UnmarshalBeaconBlockFromABCIRequestWithSpec[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+](
+	req ABCIRequest,
+	bzIndex uint,
+	chainSpec common.ChainSpec,
+) (BeaconBlockT, error) {
+	var blk BeaconBlockT
+	if req == nil {
+		return blk, ErrNilABCIRequest
+	}
+
+	//#nosec:G701 // the height is validated to be positive by the service.
+	return UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
+		req,
+		bzIndex,
+		chainSpec.ActiveForkVersionForSlot(math.Slot(req.GetHeight())),
+	)
 }
 
 // UnmarshalBlobSidecarsFromABCIRequest extracts blob sidecars from an ABCI
@@ -133,3 +195,48 @@ func UnmarshalBlobSidecarsFromABCIRequest[
 	sidecars = sidecars.Empty()
 	return sidecars, sidecars.UnmarshalSSZ(sidecarBz)
 }
+
+// VerifyBlobSidecarsCount ensures there is exactly one blob sidecar per KZG
+// commitment in the body of the given beacon block.
+func VerifyBlobSidecarsCount[
+	BeaconBlockT BeaconBlock[BeaconBlockT],
+	BlobSidecarsT interface{ Len() int },
+](
+	blk BeaconBlockT,
+	sidecars BlobSidecarsT,
+) error {
+	if sidecars.Len() != blk.GetNumBlobKzgCommitments() {
+		return errors.Wrapf(
+			ErrSidecarCountMismatch,
+			"%d sidecars, %d commitments",
+			sidecars.Len(), blk.GetNumBlobKzgCommitments(),
+		)
+	}
+	return nil
+}
+
+// beaconBlockBzFromABCIRequest returns the encoded beacon block at the given
+// index of the transactions of an ABCI request.
+func beaconBlockBzFromABCIRequest(
+	req ABCIRequest,
+	bzIndex uint,
+) ([]byte, error) {
+	txs := req.GetTxs()
+	lenTxs := uint(len(txs))
+
+	// Ensure there are transactions in the request and that the request is
+	// valid.
+	if txs == nil || lenTxs == 0 {
+		return nil, ErrNoBeaconBlockInRequest
+	}
+	if bzIndex >= lenTxs {
+		return nil, ErrBzIndexOutOfBounds
+	}
+
+	// Extract the beacon block from the ABCI request.
+	blkBz := txs[bzIndex]
+	if blkBz == nil {
+		return nil, ErrNilBeaconBlockInRequest
+	}
+	return blkBz, nil
+}
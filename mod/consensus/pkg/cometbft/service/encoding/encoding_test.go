@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package encoding_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeBlock(t *testing.T, slot math.Slot) []byte {
+	t.Helper()
+	bz, err := (&types.BeaconBlock{
+		Slot: slot,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}).MarshalSSZ()
+	require.NoError(t, err)
+	return bz
+}
+
+func TestUnmarshalBeaconBlocksFromABCIRequest(t *testing.T) {
+	txs := [][]byte{{}, encodeBlock(t, 1), encodeBlock(t, 2), {0x01}}
+
+	tests := []struct {
+		name       string
+		startIndex uint
+		count      uint
+		wantSlots  []math.Slot
+		wantErr    error
+	}{
+		{
+			name:       "Consecutive blocks",
+			startIndex: 1,
+			count:      2,
+			wantSlots:  []math.Slot{1, 2},
+		},
+		{
+			name:       "No blocks",
+			startIndex: 1,
+			count:      0,
+			wantSlots:  []math.Slot{},
+		},
+		{
+			name:       "Out of bounds",
+			startIndex: 4,
+			count:      1,
+			wantErr:    encoding.ErrBzIndexOutOfBounds,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blks, err := encoding.
+				UnmarshalBeaconBlocksFromABCIRequest[*types.BeaconBlock](
+				&cmtabci.ProcessProposalRequest{Txs: txs},
+				tt.startIndex,
+				tt.count,
+				version.Deneb,
+			)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				require.Nil(t, blks)
+				return
+			}
+			require.NoError(t, err)
+			slots := make([]math.Slot, 0, len(blks))
+			for _, blk := range blks {
+				slots = append(slots, blk.GetSlot())
+			}
+			require.Equal(t, tt.wantSlots, slots)
+		})
+	}
+
+	// The index of the first malformed block is reported.
+	_, err := encoding.UnmarshalBeaconBlocksFromABCIRequest[*types.BeaconBlock](
+		&cmtabci.ProcessProposalRequest{Txs: txs}, 1, 3, version.Deneb,
+	)
+	require.ErrorContains(t, err, "beacon block at index 3")
+}
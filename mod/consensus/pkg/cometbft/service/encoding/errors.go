@@ -39,6 +39,19 @@ var (
 	// is nil.
 	ErrNilABCIRequest = errors.New("nil abci request")
 
+	// ErrBlockSlotMismatch is an error for when the slot of the beacon block
+	// in an abci request does not match the height of the request.
+	ErrBlockSlotMismatch = errors.New(
+		"beacon block slot does not match abci request height",
+	)
+
+	// ErrSidecarCountMismatch is an error for when the number of blob
+	// sidecars in an abci request differs from the number of KZG commitments
+	// in the beacon block.
+	ErrSidecarCountMismatch = errors.New(
+		"blob sidecar count does not match kzg commitment count",
+	)
+
 	// ErrInvalidType is an error for when the type is invalid.
 	ErrInvalidType = errors.New("invalid type")
 )
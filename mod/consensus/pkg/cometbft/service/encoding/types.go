@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 // ABCIRequest represents the interface for an ABCI request.
@@ -39,4 +40,6 @@ type ABCIRequest interface {
 type BeaconBlock[T any] interface {
 	constraints.SSZMarshallable
 	NewFromSSZ([]byte, uint32) (T, error)
+	GetSlot() math.Slot
+	GetNumBlobKzgCommitments() int
 }
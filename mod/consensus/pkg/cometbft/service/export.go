@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package cometbft
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/cometbft/cometbft/crypto/bls12381"
+	cmttypes "github.com/cometbft/cometbft/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+var (
+	errZeroHeightExport = errors.New(
+		"exporting for zero height is not supported",
+	)
+	errNilGenesisExporter = errors.New("genesis exporter is not set")
+)
+
+// ExportAppStateAndValidators exports the application state and validator
+// set of the last committed block, to be used as the genesis of a new chain
+// started at the next height. Exporting for zero height is not supported.
+func (s *Service[_]) ExportAppStateAndValidators(
+	forZeroHeight bool,
+	_, _ []string,
+) (servertypes.ExportedApp, error) {
+	if forZeroHeight {
+		return servertypes.ExportedApp{}, errZeroHeightExport
+	}
+	if s.genesisExporter == nil {
+		return servertypes.ExportedApp{}, errNilGenesisExporter
+	}
+
+	height := s.LastBlockHeight()
+	ctx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	beaconGenesis, valUpdates, err := s.genesisExporter.ExportGenesis(ctx)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		"beacon": beaconGenesis,
+	})
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	validators := make([]cmttypes.GenesisValidator, 0, len(valUpdates))
+	for _, update := range valUpdates {
+		pubKey := bls12381.PubKey(update.Pubkey[:])
+		validators = append(validators, cmttypes.GenesisValidator{
+			Address: pubKey.Address(),
+			PubKey:  pubKey,
+			//#nosec:G701 // this is safe.
+			Power: int64(update.EffectiveBalance.Unwrap()),
+		})
+	}
+
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          height + 1,
+		ConsensusParams: *s.paramStore.Get(),
+	}, nil
+}
+
+// ExportBeaconStateSSZ returns the SSZ encoding of the beacon state at the
+// given height, which must not be above the last committed height.
+func (s *Service[_]) ExportBeaconStateSSZ(height int64) ([]byte, error) {
+	if s.genesisExporter == nil {
+		return nil, errNilGenesisExporter
+	}
+	if lastHeight := s.LastBlockHeight(); height <= 0 || height > lastHeight {
+		return nil, fmt.Errorf(
+			"%w: %d, latest committed height is %d",
+			errInvalidHeight, height, lastHeight,
+		)
+	}
+
+	ctx, err := s.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.genesisExporter.ExportStateSSZ(ctx)
+}
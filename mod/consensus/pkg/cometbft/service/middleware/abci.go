@@ -22,14 +22,18 @@ package middleware
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 	cmtabci "github.com/cometbft/cometbft/abci/types"
 )
 
@@ -46,7 +50,7 @@ func (h *ABCIMiddleware[
 ) (transition.ValidatorUpdates, error) {
 	var (
 		err             error
-		waitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		waitCtx, cancel = context.WithTimeout(ctx, h.timeout)
 	)
 	defer cancel()
 
@@ -56,7 +60,17 @@ func (h *ABCIMiddleware[
 		return nil, err
 	}
 
-	if err = h.dispatcher.Publish(
+	if err = h.validateGenesisForkVersion(*data); err != nil {
+		h.logger.Error("Invalid genesis data", "error", err)
+		return nil, err
+	}
+
+	if err = (*data).Validate(); err != nil {
+		h.logger.Error("Invalid genesis data", "error", err)
+		return nil, err
+	}
+
+	if err = h.publish(
 		async.NewEvent(ctx, async.GenesisDataReceived, *data),
 	); err != nil {
 		return nil, err
@@ -64,6 +78,23 @@ func (h *ABCIMiddleware[
 	return h.waitForGenesisProcessed(waitCtx)
 }
 
+// validateGenesisForkVersion ensures the fork version declared in the genesis
+// matches the fork version the chain spec expects at the genesis slot.
+func (h *ABCIMiddleware[
+	_, _, GenesisT, _,
+]) validateGenesisForkVersion(genesis GenesisT) error {
+	expected := version.FromUint32[common.Version](
+		h.chainSpec.ActiveForkVersionForSlot(math.Slot(constants.GenesisSlot)),
+	)
+	if actual := genesis.GetForkVersion(); actual != expected {
+		return errors.Wrapf(
+			ErrGenesisForkVersionMismatch,
+			"genesis: %s, chain spec: %s", actual, expected,
+		)
+	}
+	return nil
+}
+
 // waitForGenesisProcessed waits until the genesis data has been processed and
 // returns the validator updates, or err if the context is cancelled.
 func (h *ABCIMiddleware[
@@ -96,11 +127,13 @@ func (h *ABCIMiddleware[
 		builtSidecars    BlobSidecarsT
 		numMsgs          int
 		startTime        = time.Now()
-		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		awaitCtx, cancel = context.WithTimeout(ctx, h.timeout)
 	)
 
 	defer cancel()
-	defer h.metrics.measurePrepareProposalDuration(startTime)
+	defer h.metrics.measurePrepareProposalDuration(
+		startTime, h.chainSpec.ActiveForkVersionForSlot(slotData.GetSlot()),
+	)
 	// flush the channels to ensure that we are not handling old data.
 	if numMsgs = async.ClearChan(h.subBuiltBeaconBlock); numMsgs > 0 {
 		h.logger.Error(
@@ -113,7 +146,7 @@ func (h *ABCIMiddleware[
 			"num_msgs", numMsgs)
 	}
 
-	if err = h.dispatcher.Publish(
+	if err = h.publish(
 		async.NewEvent(
 			ctx, async.NewSlot, slotData,
 		),
@@ -121,19 +154,34 @@ func (h *ABCIMiddleware[
 		return nil, nil, err
 	}
 
-	// wait for built beacon block
-	builtBeaconBlock, err = h.waitForBuiltBeaconBlock(awaitCtx)
+	builtBeaconBlock, builtSidecars, err = h.waitForBuiltBeaconBlockAndSidecars(
+		awaitCtx,
+	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// wait for built sidecars
-	builtSidecars, err = h.waitForBuiltSidecars(awaitCtx)
+	return h.handleBuiltBeaconBlockAndSidecars(builtBeaconBlock, builtSidecars)
+}
+
+// waitForBuiltBeaconBlockAndSidecars waits for both the built beacon block
+// and the built sidecars to be received.
+func (h *ABCIMiddleware[
+	BeaconBlockT, BlobSidecarsT, _, _,
+]) waitForBuiltBeaconBlockAndSidecars(
+	ctx context.Context,
+) (BeaconBlockT, BlobSidecarsT, error) {
+	defer h.metrics.measureBuildBlockAndSidecarsDuration(time.Now())
+
+	// wait for built beacon block
+	builtBeaconBlock, err := h.waitForBuiltBeaconBlock(ctx)
 	if err != nil {
-		return nil, nil, err
+		return builtBeaconBlock, *new(BlobSidecarsT), err
 	}
 
-	return h.handleBuiltBeaconBlockAndSidecars(builtBeaconBlock, builtSidecars)
+	// wait for built sidecars
+	builtSidecars, err := h.waitForBuiltSidecars(ctx)
+	return builtBeaconBlock, builtSidecars, err
 }
 
 // waitForBuiltBeaconBlock waits for the built beacon block to be received.
@@ -172,6 +220,12 @@ func (h *ABCIMiddleware[
 	bb BeaconBlockT,
 	sc BlobSidecarsT,
 ) ([]byte, []byte, error) {
+	defer h.metrics.measureGossipBlockAndSidecarsDuration(
+		time.Now(),
+	)
+	if bb.IsNil() {
+		return nil, nil, ErrNilBuiltBeaconBlock
+	}
 	bbBz, bbErr := bb.MarshalSSZ()
 	if bbErr != nil {
 		return nil, nil, bbErr
@@ -190,18 +244,56 @@ func (h *ABCIMiddleware[
 // ProcessProposal processes the proposal for the ABCI middleware.
 // It handles both the beacon block and blob sidecars concurrently.
 func (h *ABCIMiddleware[
-	BeaconBlockT, BlobSidecarsT, _, _,
+	_, _, _, _,
 ]) ProcessProposal(
 	ctx context.Context,
 	req *cmtabci.ProcessProposalRequest,
 ) (*cmtabci.ProcessProposalResponse, error) {
+	defer h.metrics.measureProcessProposalDuration(
+		time.Now(),
+		h.chainSpec.ActiveForkVersionForSlot(math.Slot(req.GetHeight())),
+	)
+
+	report, err := h.verifyProposal(ctx, req)
+	if err != nil {
+		if !errors.IsFatal(err) {
+			err = errors.WrapNonFatal(err)
+		}
+		return h.createProcessProposalResponse(err)
+	}
+
+	// err if the beacon block or sidecars failed verification.
+	return h.createProcessProposalResponse(report.Err())
+}
+
+// DryRunProcessProposal runs the same verification path as ProcessProposal
+// and reports the outcome and duration of each check, without constructing
+// a CometBFT response. It returns an error if the proposal could not be
+// decoded or dispatched for verification.
+func (h *ABCIMiddleware[
+	_, _, _, _,
+]) DryRunProcessProposal(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+) (*ProposalVerificationReport, error) {
+	return h.verifyProposal(ctx, req)
+}
+
+// verifyProposal decodes the beacon block and blob sidecars from the request,
+// dispatches them for verification and waits for both results concurrently.
+func (h *ABCIMiddleware[
+	BeaconBlockT, BlobSidecarsT, _, _,
+]) verifyProposal(
+	ctx context.Context,
+	req *cmtabci.ProcessProposalRequest,
+) (*ProposalVerificationReport, error) {
 	var (
 		err              error
-		startTime        = time.Now()
 		blk              BeaconBlockT
 		numMsgs          int
 		sidecars         BlobSidecarsT
-		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		startTime        = time.Now()
+		awaitCtx, cancel = context.WithTimeout(ctx, h.timeout)
 	)
 	defer cancel()
 	// flush the channels to ensure that we are not handling old data.
@@ -216,48 +308,94 @@ func (h *ABCIMiddleware[
 			"num_msgs", numMsgs)
 	}
 
-	defer h.metrics.measureProcessProposalDuration(startTime)
+	// Reject an oversized beacon block before decoding it.
+	if err = h.verifyBlockSize(req); err != nil {
+		return nil, err
+	}
 
 	// Request the beacon block.
 	if blk, err = encoding.
-		UnmarshalBeaconBlockFromABCIRequest[BeaconBlockT](
-		req, 0, h.chainSpec.ActiveForkVersionForSlot(math.U64(req.Height)),
-	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
-	}
-
-	// notify that the beacon block has been received.
-	if err = h.dispatcher.Publish(
-		async.NewEvent(ctx, async.BeaconBlockReceived, blk),
+		UnmarshalBeaconBlockFromABCIRequestWithSpec[BeaconBlockT](
+		req, h.beaconBlockTxIndex, h.chainSpec,
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return nil, err
 	}
 
 	// Request the blob sidecars.
 	if sidecars, err = encoding.
 		UnmarshalBlobSidecarsFromABCIRequest[BlobSidecarsT](
-		req, 1,
+		req, h.blobSidecarsTxIndex,
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return nil, err
+	}
+
+	// Reject a malformed proposal before dispatching it for verification.
+	if err = encoding.VerifyBlobSidecarsCount(blk, sidecars); err != nil {
+		return nil, err
+	}
+
+	// notify that the beacon block has been received.
+	if err = h.publish(
+		async.NewEvent(ctx, async.BeaconBlockReceived, blk),
+	); err != nil {
+		return nil, err
 	}
 
 	// notify that the sidecars have been received.
-	if err = h.dispatcher.Publish(
+	if err = h.publish(
 		async.NewEvent(ctx, async.SidecarsReceived, sidecars),
 	); err != nil {
-		return h.createProcessProposalResponse(errors.WrapNonFatal(err))
+		return nil, err
 	}
 
-	// err if the built beacon block or sidecars failed verification.
-	_, err = h.waitForBeaconBlockVerification(awaitCtx)
-	if err != nil {
-		return h.createProcessProposalResponse(err)
+	return h.waitForProposalVerification(awaitCtx, startTime), nil
+}
+
+// verifyBlockSize returns a fatal ErrBlockTooLarge if the marshalled beacon
+// block of the proposal exceeds the configured maximum size. A missing block
+// is left for the decoder to report.
+func (h *ABCIMiddleware[
+	_, _, _, _,
+]) verifyBlockSize(req *cmtabci.ProcessProposalRequest) error {
+	txs := req.GetTxs()
+	if uint(len(txs)) <= h.beaconBlockTxIndex {
+		return nil
 	}
-	_, err = h.waitForSidecarVerification(awaitCtx)
-	if err != nil {
-		return h.createProcessProposalResponse(err)
+	size := uint64(len(txs[h.beaconBlockTxIndex]))
+	if size <= h.maxBlockBytes {
+		return nil
 	}
-	return h.createProcessProposalResponse(nil)
+	h.metrics.markProposalTooLarge()
+	return errors.WrapFatal(errors.Wrapf(
+		ErrBlockTooLarge, "size %d, max %d", size, h.maxBlockBytes,
+	))
+}
+
+// waitForProposalVerification waits for the beacon block and the blob
+// sidecars to be verified concurrently and joins on both results.
+func (h *ABCIMiddleware[
+	_, _, _, _,
+]) waitForProposalVerification(
+	ctx context.Context,
+	startTime time.Time,
+) *ProposalVerificationReport {
+	var (
+		report ProposalVerificationReport
+		wg     sync.WaitGroup
+	)
+	wg.Add(2) //nolint:mnd // block and sidecars.
+	go func() {
+		defer wg.Done()
+		_, report.BeaconBlock.Err = h.waitForBeaconBlockVerification(ctx)
+		report.BeaconBlock.Duration = time.Since(startTime)
+	}()
+	go func() {
+		defer wg.Done()
+		_, report.Sidecars.Err = h.waitForSidecarVerification(ctx)
+		report.Sidecars.Duration = time.Since(startTime)
+	}()
+	wg.Wait()
+	return &report
 }
 
 // waitForBeaconBlockVerification waits for the built beacon block to be
@@ -318,7 +456,7 @@ func (h *ABCIMiddleware[
 		err              error
 		blk              BeaconBlockT
 		blobs            BlobSidecarsT
-		awaitCtx, cancel = context.WithTimeout(ctx, AwaitTimeout)
+		awaitCtx, cancel = context.WithTimeout(ctx, h.timeout)
 	)
 	defer cancel()
 	// flush the channel to ensure that we are not handling old data.
@@ -328,31 +466,40 @@ func (h *ABCIMiddleware[
 			"num_msgs", numMsgs)
 	}
 
+	// If there are no transactions there is no block to finalize.
+	if len(req.GetTxs()) == 0 {
+		return nil, nil
+	}
+
 	blk, blobs, err = encoding.
 		ExtractBlobsAndBlockFromRequest[BeaconBlockT, BlobSidecarsT](
 		req,
-		BeaconBlockTxIndex,
-		BlobSidecarsTxIndex,
+		h.beaconBlockTxIndex,
+		h.blobSidecarsTxIndex,
 		h.chainSpec.ActiveForkVersionForSlot(
 			math.Slot(req.Height),
 		))
 	if err != nil {
-		// If we don't have a block, we can't do anything.
-		return nil, nil
+		// A malformed block must halt the node rather than silently
+		// finalizing without validator updates.
+		return nil, err
 	}
 
 	// notify that the final beacon block has been received.
-	if err = h.dispatcher.Publish(
+	if err = h.publish(
 		async.NewEvent(ctx, async.FinalBeaconBlockReceived, blk),
 	); err != nil {
 		return nil, err
 	}
 
-	// notify that the final blob sidecars have been received.
-	if err = h.dispatcher.Publish(
-		async.NewEvent(ctx, async.FinalSidecarsReceived, blobs),
-	); err != nil {
-		return nil, err
+	// notify that the final blob sidecars have been received. The sidecars
+	// of a simulated block must not be persisted, so they are not published.
+	if !transition.IsSimulation(ctx) {
+		if err = h.publish(
+			async.NewEvent(ctx, async.FinalSidecarsReceived, blobs),
+		); err != nil {
+			return nil, err
+		}
 	}
 
 	// wait for the final validator updates.
@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/async/pkg/dispatcher"
+	asynctypes "github.com/berachain/beacon-kit/mod/async/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/encoding"
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
+	cmtabci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+type (
+	testGenesis = types.Genesis[
+		*types.Deposit, *types.ExecutionPayloadHeader,
+	]
+	testMiddleware = middleware.ABCIMiddleware[
+		*types.BeaconBlock, *blobSidecars, *testGenesis, slotData,
+	]
+)
+
+// slotData requests a block for the first slot.
+type slotData struct{}
+
+func (slotData) GetSlot() math.Slot {
+	return 1
+}
+
+// blobSidecars is a minimal BlobSidecars implementation for testing.
+type blobSidecars struct {
+	bz []byte
+}
+
+func (*blobSidecars) Empty() *blobSidecars {
+	return &blobSidecars{}
+}
+
+func (s *blobSidecars) MarshalSSZ() ([]byte, error) {
+	return s.bz, nil
+}
+
+func (s *blobSidecars) UnmarshalSSZ(bz []byte) error {
+	s.bz = bz
+	return nil
+}
+
+// Len treats every byte of the encoding as a sidecar.
+func (s *blobSidecars) Len() int {
+	return len(s.bz)
+}
+
+// telemetrySink discards all metrics.
+type telemetrySink struct{}
+
+func (telemetrySink) IncrementCounter(string, ...string) {}
+
+func (telemetrySink) SetGauge(string, float64, ...string) {}
+
+func (telemetrySink) MeasureSince(string, time.Time, ...string) {}
+
+// chainSpec overrides the fork version lookup of the embedded chain spec.
+type chainSpec struct {
+	common.ChainSpec
+}
+
+func (chainSpec) ActiveForkVersionForSlot(math.Slot) uint32 {
+	return version.Deneb
+}
+
+func newTestMiddleware(
+	d asynctypes.EventDispatcher,
+) *testMiddleware {
+	return newTestMiddlewareWithMaxBlockBytes(d, middleware.MaxBlockBytes)
+}
+
+// newTestMiddlewareWithMaxBlockBytes creates a middleware accepting beacon
+// blocks of at most maxBlockBytes.
+func newTestMiddlewareWithMaxBlockBytes(
+	d asynctypes.EventDispatcher,
+	maxBlockBytes uint64,
+) *testMiddleware {
+	return middleware.NewABCIMiddleware[
+		*types.BeaconBlock, *blobSidecars, *testGenesis, slotData,
+	](
+		chainSpec{},
+		d,
+		noop.NewLogger[any](),
+		telemetrySink{},
+		middleware.AwaitTimeout,
+		middleware.BeaconBlockTxIndex,
+		middleware.BlobSidecarsTxIndex,
+		maxBlockBytes,
+	)
+}
+
+// newTestDispatcher creates a dispatcher with brokers for every event the
+// middleware publishes or subscribes to.
+func newTestDispatcher(t *testing.T) *dispatcher.Dispatcher {
+	t.Helper()
+	d, err := dispatcher.New(
+		noop.NewLogger[any](),
+		dispatcher.WithEvent[async.Event[slotData]](async.NewSlot),
+		dispatcher.WithEvent[async.Event[*types.BeaconBlock]](
+			async.BuiltBeaconBlock,
+		),
+		dispatcher.WithEvent[async.Event[*blobSidecars]](
+			async.BuiltSidecars,
+		),
+		dispatcher.WithEvent[async.Event[*types.BeaconBlock]](
+			async.BeaconBlockVerified,
+		),
+		dispatcher.WithEvent[async.Event[*blobSidecars]](
+			async.SidecarsVerified,
+		),
+		dispatcher.WithEvent[async.Event[transition.ValidatorUpdates]](
+			async.GenesisDataProcessed,
+		),
+		dispatcher.WithEvent[async.Event[transition.ValidatorUpdates]](
+			async.FinalValidatorUpdatesProcessed,
+		),
+	)
+	require.NoError(t, err)
+	return d
+}
+
+func TestPrepareProposalNilBuiltBeaconBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := newTestDispatcher(t)
+	m := newTestMiddleware(d)
+	require.NoError(t, m.Start(ctx))
+
+	// Reply to the new slot with a zero value beacon block.
+	newSlots := make(chan async.Event[slotData])
+	require.NoError(t, d.Subscribe(async.NewSlot, newSlots))
+	require.NoError(t, d.Start(ctx))
+	go func() {
+		event, ok := <-newSlots
+		if !ok {
+			return
+		}
+		_ = d.Publish(async.NewEvent(
+			event.Context(), async.BuiltBeaconBlock, (*types.BeaconBlock)(nil),
+		))
+		_ = d.Publish(async.NewEvent(
+			event.Context(), async.BuiltSidecars, &blobSidecars{},
+		))
+	}()
+
+	blkBz, sidecarsBz, err := m.PrepareProposal(ctx, slotData{})
+	require.ErrorIs(t, err, middleware.ErrNilBuiltBeaconBlock)
+	require.Nil(t, blkBz)
+	require.Nil(t, sidecarsBz)
+}
+
+func TestInitGenesisForkVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		forkVersion uint32
+		wantErr     error
+	}{
+		{
+			name:        "Mismatched fork version",
+			forkVersion: version.Capella,
+			wantErr:     middleware.ErrGenesisForkVersionMismatch,
+		},
+		{
+			name:        "Future fork version",
+			forkVersion: version.Electra,
+			wantErr:     middleware.ErrGenesisForkVersionMismatch,
+		},
+		{
+			name:        "Matching fork version without deposits",
+			forkVersion: version.Deneb,
+			wantErr:     types.ErrNoGenesisDeposits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesis := types.DefaultGenesisDeneb()
+			genesis.ForkVersion = version.FromUint32[common.Version](
+				tt.forkVersion,
+			)
+			bz, err := json.Marshal(genesis)
+			require.NoError(t, err)
+
+			updates, err := newTestMiddleware(nil).InitGenesis(
+				context.Background(), bz,
+			)
+			require.ErrorIs(t, err, tt.wantErr)
+			require.Nil(t, updates)
+		})
+	}
+}
+
+func TestFinalizeBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		txs     [][]byte
+		wantErr bool
+	}{
+		{
+			name:    "No transactions",
+			txs:     nil,
+			wantErr: false,
+		},
+		{
+			name:    "Empty transactions",
+			txs:     [][]byte{},
+			wantErr: false,
+		},
+		{
+			name:    "Corrupt beacon block",
+			txs:     [][]byte{{0x01, 0x02, 0x03}, {}},
+			wantErr: true,
+		},
+		{
+			name:    "Missing blob sidecars",
+			txs:     [][]byte{{0x01, 0x02, 0x03}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updates, err := newTestMiddleware(nil).FinalizeBlock(
+				context.Background(),
+				&cmtabci.FinalizeBlockRequest{Txs: tt.txs, Height: 1},
+			)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Nil(t, updates)
+		})
+	}
+}
+
+func TestFinalizeBlockSlotMismatch(t *testing.T) {
+	blk := &types.BeaconBlock{
+		Slot: 2,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	blkBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	updates, err := newTestMiddleware(nil).FinalizeBlock(
+		context.Background(),
+		&cmtabci.FinalizeBlockRequest{Txs: [][]byte{blkBz, {}}, Height: 1},
+	)
+	require.ErrorIs(t, err, encoding.ErrBlockSlotMismatch)
+	require.Nil(t, updates)
+}
+
+func TestFinalizeBlockSidecarCountMismatch(t *testing.T) {
+	blk := &types.BeaconBlock{
+		Slot: 1,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	blkBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	updates, err := newTestMiddleware(nil).FinalizeBlock(
+		context.Background(),
+		&cmtabci.FinalizeBlockRequest{
+			Txs:    [][]byte{blkBz, {0x01}},
+			Height: 1,
+		},
+	)
+	require.ErrorIs(t, err, encoding.ErrSidecarCountMismatch)
+	require.Nil(t, updates)
+}
+
+func TestDryRunProcessProposalSlotMismatch(t *testing.T) {
+	blk := &types.BeaconBlock{
+		Slot: 2,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	blkBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	report, err := newTestMiddleware(nil).DryRunProcessProposal(
+		context.Background(),
+		&cmtabci.ProcessProposalRequest{Txs: [][]byte{blkBz, {}}, Height: 1},
+	)
+	require.ErrorIs(t, err, encoding.ErrBlockSlotMismatch)
+	require.Nil(t, report)
+}
+
+func TestProcessProposalBlockTooLarge(t *testing.T) {
+	blk := &types.BeaconBlock{
+		Slot: 1,
+		Body: (&types.BeaconBlockBody{}).Empty(version.Deneb),
+	}
+	blkBz, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+
+	m := newTestMiddlewareWithMaxBlockBytes(nil, uint64(len(blkBz))-1)
+	resp, err := m.ProcessProposal(
+		context.Background(),
+		&cmtabci.ProcessProposalRequest{Txs: [][]byte{blkBz, {}}, Height: 1},
+	)
+	require.ErrorContains(t, err, middleware.ErrBlockTooLarge.Error())
+	require.Equal(t, cmtabci.PROCESS_PROPOSAL_STATUS_REJECT, resp.GetStatus())
+}
@@ -23,12 +23,16 @@ package middleware
 import "time"
 
 const (
-	// BeaconBlockTxIndex represents the index of the beacon block transaction.
-	// It is the first transaction in the tx list.
+	// BeaconBlockTxIndex represents the default index of the beacon block
+	// transaction. It is the first transaction in the tx list.
 	BeaconBlockTxIndex uint = iota
-	// BlobSidecarsTxIndex represents the index of the blob sidecar transaction.
-	// It follows the beacon block transaction in the tx list.
+	// BlobSidecarsTxIndex represents the default index of the blob sidecar
+	// transaction. It follows the beacon block transaction in the tx list.
 	BlobSidecarsTxIndex
-	// AwaitTimeout is the timeout for awaiting events.
+	// AwaitTimeout is the default timeout for publishing and awaiting events.
 	AwaitTimeout = 2 * time.Second
+	// MaxBlockBytes is the default maximum size in bytes of a marshalled
+	// beacon block accepted in a proposal. It matches the hard limit CometBFT
+	// places on the size of a block.
+	MaxBlockBytes uint64 = 104857600
 )
@@ -27,6 +27,25 @@ var (
 	// ErrUnexpectedEvent is returned when an unexpected event is encountered.
 	ErrUnexpectedEvent = errors.New("unexpected event")
 
+	// ErrNilBuiltBeaconBlock is returned when the built beacon block is nil.
+	ErrNilBuiltBeaconBlock = errors.New("built beacon block is nil")
+
+	// ErrGenesisForkVersionMismatch is returned when the fork version of the
+	// genesis does not match the genesis fork version of the chain spec.
+	ErrGenesisForkVersionMismatch = errors.New(
+		"genesis fork version does not match chain spec",
+	)
+
+	// ErrDispatcherTimeout is returned when the dispatcher does not accept
+	// an event within the configured timeout.
+	ErrDispatcherTimeout = errors.New(
+		"A timeout occurred while publishing an event to the dispatcher",
+	)
+
+	// ErrBlockTooLarge is returned when the marshalled beacon block of a
+	// proposal exceeds the configured maximum size.
+	ErrBlockTooLarge = errors.New("beacon block exceeds maximum size")
+
 	ErrInitGenesisTimeout = func(errTimeout error) error {
 		return errors.Wrapf(errTimeout,
 			"A timeout occurred while waiting for genesis data processing",
@@ -21,6 +21,7 @@
 package middleware
 
 import (
+	"strconv"
 	"time"
 )
 
@@ -39,20 +40,51 @@ func newABCIMiddlewareMetrics(
 	}
 }
 
-// measurePrepareProposalDuration measures the time to prepare.
+// measurePrepareProposalDuration measures the time to prepare, labelled by
+// the active fork version.
 func (cm *ABCIMiddlewareMetrics) measurePrepareProposalDuration(
-	start time.Time,
+	start time.Time, forkVersion uint32,
 ) {
 	cm.sink.MeasureSince(
 		"beacon_kit.runtime.prepare_proposal_duration", start,
+		"fork_version", strconv.FormatUint(uint64(forkVersion), 10),
 	)
 }
 
-// measureProcessProposalDuration measures the time to process.
-func (cm *ABCIMiddlewareMetrics) measureProcessProposalDuration(
+// measureBuildBlockAndSidecarsDuration measures the time spent waiting
+// for the beacon block and sidecars to be built.
+func (cm *ABCIMiddlewareMetrics) measureBuildBlockAndSidecarsDuration(
+	start time.Time,
+) {
+	cm.sink.MeasureSince(
+		"beacon_kit.runtime.build_beacon_block_and_sidecars_duration", start,
+	)
+}
+
+// measureGossipBlockAndSidecarsDuration measures the time to prepare the
+// built beacon block and sidecars for gossip.
+func (cm *ABCIMiddlewareMetrics) measureGossipBlockAndSidecarsDuration(
 	start time.Time,
+) {
+	cm.sink.MeasureSince(
+		"beacon_kit.runtime.gossip_beacon_block_and_sidecars_duration",
+		start,
+	)
+}
+
+// measureProcessProposalDuration measures the time to process, labelled by
+// the active fork version.
+func (cm *ABCIMiddlewareMetrics) measureProcessProposalDuration(
+	start time.Time, forkVersion uint32,
 ) {
 	cm.sink.MeasureSince(
 		"beacon_kit.runtime.process_proposal_duration", start,
+		"fork_version", strconv.FormatUint(uint64(forkVersion), 10),
 	)
 }
+
+// markProposalTooLarge increments the counter of proposals rejected because
+// their beacon block exceeds the maximum size.
+func (cm *ABCIMiddlewareMetrics) markProposalTooLarge() {
+	cm.sink.IncrementCounter("beacon_kit.runtime.proposal_too_large")
+}
@@ -22,20 +22,20 @@ package middleware
 
 import (
 	"context"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/async/pkg/types"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
-	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
 )
 
 // ABCIMiddleware is a middleware between ABCI and the validator logic.
 type ABCIMiddleware[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BlobSidecarsT BlobSidecars[BlobSidecarsT],
-	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	GenesisT Genesis,
+	SlotDataT SlotData,
 ] struct {
 	// chainSpec is the chain specification.
 	chainSpec common.ChainSpec
@@ -45,6 +45,15 @@ type ABCIMiddleware[
 	metrics *ABCIMiddlewareMetrics
 	// logger is the logger for the middleware.
 	logger log.Logger
+	// timeout is the timeout for publishing and awaiting events.
+	timeout time.Duration
+	// beaconBlockTxIndex is the index of the beacon block in the tx list.
+	beaconBlockTxIndex uint
+	// blobSidecarsTxIndex is the index of the blob sidecars in the tx list.
+	blobSidecarsTxIndex uint
+	// maxBlockBytes is the maximum size of a marshalled beacon block accepted
+	// in a proposal.
+	maxBlockBytes uint64
 	// subGenDataProcessed is the channel to hold GenesisDataProcessed events.
 	subGenDataProcessed chan async.Event[validatorUpdates]
 	// subBuiltBeaconBlock is the channel to hold BuiltBeaconBlock events.
@@ -64,13 +73,17 @@ type ABCIMiddleware[
 func NewABCIMiddleware[
 	BeaconBlockT BeaconBlock[BeaconBlockT],
 	BlobSidecarsT BlobSidecars[BlobSidecarsT],
-	GenesisT json.Unmarshaler,
-	SlotDataT any,
+	GenesisT Genesis,
+	SlotDataT SlotData,
 ](
 	chainSpec common.ChainSpec,
 	dispatcher types.EventDispatcher,
 	logger log.Logger,
 	telemetrySink TelemetrySink,
+	timeout time.Duration,
+	beaconBlockTxIndex uint,
+	blobSidecarsTxIndex uint,
+	maxBlockBytes uint64,
 ) *ABCIMiddleware[
 	BeaconBlockT, BlobSidecarsT, GenesisT, SlotDataT,
 ] {
@@ -81,6 +94,10 @@ func NewABCIMiddleware[
 		dispatcher:               dispatcher,
 		logger:                   logger,
 		metrics:                  newABCIMiddlewareMetrics(telemetrySink),
+		timeout:                  timeout,
+		beaconBlockTxIndex:       beaconBlockTxIndex,
+		blobSidecarsTxIndex:      blobSidecarsTxIndex,
+		maxBlockBytes:            maxBlockBytes,
 		subGenDataProcessed:      make(chan async.Event[validatorUpdates]),
 		subBuiltBeaconBlock:      make(chan async.Event[BeaconBlockT]),
 		subBuiltSidecars:         make(chan async.Event[BlobSidecarsT]),
@@ -90,6 +107,26 @@ func NewABCIMiddleware[
 	}
 }
 
+// publish publishes the given event to the dispatcher, returning
+// ErrDispatcherTimeout if the event is not accepted within the timeout. The
+// event context is left untouched since subscribers may keep using it after
+// the event has been delivered.
+func (am *ABCIMiddleware[_, _, _, _]) publish(event async.BaseEvent) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- am.dispatcher.Publish(event)
+	}()
+
+	timer := time.NewTimer(am.timeout)
+	defer timer.Stop()
+	select {
+	case err := <-errCh:
+		return err
+	case <-timer.C:
+		return ErrDispatcherTimeout
+	}
+}
+
 // Start subscribes the middleware to the events it needs to listen for.
 func (am *ABCIMiddleware[_, _, _, _]) Start(
 	_ context.Context,
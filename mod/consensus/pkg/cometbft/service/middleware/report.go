@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware
+
+import (
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+)
+
+// ProposalCheck is the outcome of a single proposal verification check.
+type ProposalCheck struct {
+	// Err is the error returned by the check, nil if it passed.
+	Err error
+	// Duration is the time from the start of the proposal verification
+	// until the check completed.
+	Duration time.Duration
+}
+
+// Passed returns true if the check did not return an error.
+func (c ProposalCheck) Passed() bool {
+	return c.Err == nil
+}
+
+// ProposalVerificationReport describes the outcome of verifying a proposal.
+type ProposalVerificationReport struct {
+	// BeaconBlock is the outcome of the beacon block verification.
+	BeaconBlock ProposalCheck
+	// Sidecars is the outcome of the blob sidecars verification.
+	Sidecars ProposalCheck
+}
+
+// Err returns the error that decides the proposal. A fatal beacon block
+// error takes precedence over a fatal sidecars error, followed by any
+// non-fatal error in the same order.
+func (r *ProposalVerificationReport) Err() error {
+	switch {
+	case errors.IsFatal(r.BeaconBlock.Err):
+		return r.BeaconBlock.Err
+	case errors.IsFatal(r.Sidecars.Err):
+		return r.Sidecars.Err
+	case r.BeaconBlock.Err != nil:
+		return r.BeaconBlock.Err
+	default:
+		return r.Sidecars.Err
+	}
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package middleware_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus/pkg/cometbft/service/middleware"
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProposalVerificationReportErr(t *testing.T) {
+	var (
+		errFatalBlock    = errors.New("fatal block")
+		errFatalSidecars = errors.New("fatal sidecars")
+		errNonFatalBlock = errors.WrapNonFatal(errors.New("block"))
+		errNonFatalBlobs = errors.WrapNonFatal(errors.New("sidecars"))
+	)
+	tests := []struct {
+		name        string
+		blockErr    error
+		sidecarsErr error
+		want        error
+	}{
+		{
+			name: "Both passed",
+		},
+		{
+			name:        "Fatal block wins over fatal sidecars",
+			blockErr:    errFatalBlock,
+			sidecarsErr: errFatalSidecars,
+			want:        errFatalBlock,
+		},
+		{
+			name:        "Fatal sidecars wins over non-fatal block",
+			blockErr:    errNonFatalBlock,
+			sidecarsErr: errFatalSidecars,
+			want:        errFatalSidecars,
+		},
+		{
+			name:        "Non-fatal block wins over non-fatal sidecars",
+			blockErr:    errNonFatalBlock,
+			sidecarsErr: errNonFatalBlobs,
+			want:        errNonFatalBlock,
+		},
+		{
+			name:        "Non-fatal sidecars only",
+			sidecarsErr: errNonFatalBlobs,
+			want:        errNonFatalBlobs,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &middleware.ProposalVerificationReport{
+				BeaconBlock: middleware.ProposalCheck{Err: tt.blockErr},
+				Sidecars:    middleware.ProposalCheck{Err: tt.sidecarsErr},
+			}
+			require.Equal(t, tt.want, report.Err())
+			require.Equal(t, tt.blockErr == nil, report.BeaconBlock.Passed())
+			require.Equal(t, tt.sidecarsErr == nil, report.Sidecars.Passed())
+		})
+	}
+}
@@ -23,7 +23,10 @@ package middleware
 import (
 	"time"
 
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/json"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
 )
 
@@ -33,10 +36,33 @@ type BeaconBlock[SelfT any] interface {
 	constraints.Nillable
 	constraints.Empty[SelfT]
 	NewFromSSZ([]byte, uint32) (SelfT, error)
+	GetSlot() math.Slot
+	GetNumBlobKzgCommitments() int
+}
+
+// Genesis is the interface for the genesis data.
+type Genesis interface {
+	json.Unmarshaler
+	// GetForkVersion returns the fork version of the genesis slot.
+	GetForkVersion() common.Version
+	// Validate returns an error if the genesis data is malformed.
+	Validate() error
+}
+
+// SlotData is the interface for the data of the slot to propose a block for.
+type SlotData interface {
+	// GetSlot returns the slot to propose a block for.
+	GetSlot() math.Slot
 }
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value float64, args ...string)
 	// MeasureSince measures the time since the given time.
 	MeasureSince(key string, start time.Time, args ...string)
 }
@@ -44,6 +70,7 @@ type TelemetrySink interface {
 type BlobSidecars[T any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[T]
+	Len() int
 }
 
 type validatorUpdates = transition.ValidatorUpdates
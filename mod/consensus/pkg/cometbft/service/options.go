@@ -81,3 +81,11 @@ func SetChainID[
 ](chainID string) func(*Service[LoggerT]) {
 	return func(s *Service[LoggerT]) { s.chainID = chainID }
 }
+
+// SetGenesisExporter sets the genesis exporter used to export the application
+// state and validators.
+func SetGenesisExporter[
+	LoggerT log.AdvancedLogger[LoggerT],
+](exporter GenesisExporter) func(*Service[LoggerT]) {
+	return func(s *Service[LoggerT]) { s.setGenesisExporter(exporter) }
+}
@@ -57,6 +57,10 @@ type Service[
 	sm         *statem.Manager
 	Middleware MiddlewareI
 
+	// genesisExporter exports the beacon chain genesis on
+	// ExportAppStateAndValidators, if set.
+	genesisExporter GenesisExporter
+
 	// prepareProposalState is used for PrepareProposal, which is set based on the
 	// previous block's state. This state is never committed. In case of multiple
 	// consensus rounds, the state is always reset to the previous block's state.
@@ -208,6 +212,10 @@ func (s *Service[_]) setMinRetainBlocks(minRetainBlocks uint64) {
 	s.minRetainBlocks = minRetainBlocks
 }
 
+func (s *Service[_]) setGenesisExporter(exporter GenesisExporter) {
+	s.genesisExporter = exporter
+}
+
 func (s *Service[_]) setInterBlockCache(
 	cache storetypes.MultiStorePersistentCache,
 ) {
@@ -49,6 +49,19 @@ type BeaconState interface {
 	HashTreeRoot() common.Root
 }
 
+// GenesisExporter is an interface for exporting the genesis of the beacon
+// chain from the beacon state.
+type GenesisExporter interface {
+	// ExportGenesis returns the genesis of the beacon state held in the given
+	// context, along with the validator set of that state.
+	ExportGenesis(
+		ctx context.Context,
+	) ([]byte, transition.ValidatorUpdates, error)
+	// ExportStateSSZ returns the SSZ encoding of the beacon state held in the
+	// given context.
+	ExportStateSSZ(ctx context.Context) ([]byte, error)
+}
+
 type MiddlewareI interface {
 	InitGenesis(
 		ctx context.Context, bz []byte,
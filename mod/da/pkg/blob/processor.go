@@ -107,6 +107,33 @@ func (sp *Processor[AvailabilityStoreT, _, _, _, BlobSidecarsT]) VerifySidecars(
 	)
 }
 
+// VerifySidecarsBatch verifies the blobs like VerifySidecars, with a single
+// batched KZG proof verification. If the batch fails, the proofs are verified
+// one by one to identify the invalid sidecar.
+func (sp *Processor[
+	AvailabilityStoreT, _, _, _, BlobSidecarsT,
+]) VerifySidecarsBatch(
+	sidecars BlobSidecarsT,
+) error {
+	startTime := time.Now()
+	defer sp.metrics.measureVerifySidecarsDuration(
+		startTime, math.U64(sidecars.Len()),
+	)
+
+	// Abort if there are no blobs to store.
+	if sidecars.Len() == 0 {
+		return nil
+	}
+
+	return sp.verifier.VerifySidecarsBatch(
+		sidecars,
+		sp.blockBodyOffsetFn(
+			sidecars.Get(0).GetBeaconBlockHeader().GetSlot(),
+			sp.chainSpec,
+		),
+	)
+}
+
 // slot :=  processes the blobs and ensures they match the local state.
 func (sp *Processor[
 	AvailabilityStoreT, _, _, _, BlobSidecarsT,
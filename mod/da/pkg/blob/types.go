@@ -64,6 +64,7 @@ type BlobVerifier[BlobSidecarsT any] interface {
 	VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
 	VerifyKZGProofs(scs BlobSidecarsT) error
 	VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+	VerifySidecarsBatch(sidecars BlobSidecarsT, kzgOffset uint64) error
 }
 
 type Sidecar[BeaconBlockHeaderT any] interface {
@@ -88,6 +89,12 @@ type ChainSpec interface {
 
 // TelemetrySink is an interface for sending metrics to a telemetry backend.
 type TelemetrySink interface {
+	// IncrementCounter increments a counter metric identified by the provided
+	// keys.
+	IncrementCounter(key string, args ...string)
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value float64, args ...string)
 	// MeasureSince measures the time since the provided start time,
 	// identified by the provided keys.
 	MeasureSince(key string, start time.Time, args ...string)
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/kzg"
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"golang.org/x/sync/errgroup"
 )
@@ -61,6 +62,24 @@ func NewVerifier[
 // as the KZG proofs.
 func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
 	sidecars BlobSidecarsT, kzgOffset uint64,
+) error {
+	return bv.verifySidecars(sidecars, kzgOffset, bv.VerifyKZGProofs)
+}
+
+// VerifySidecarsBatch verifies the blobs like VerifySidecars, and identifies
+// the sidecar with an invalid KZG proof if the batch verification fails.
+func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecarsBatch(
+	sidecars BlobSidecarsT, kzgOffset uint64,
+) error {
+	return bv.verifySidecars(sidecars, kzgOffset, bv.VerifyKZGProofsBatch)
+}
+
+// verifySidecars verifies the inclusion proofs, the block roots and, with the
+// given function, the KZG proofs of the blobs concurrently.
+func (bv *Verifier[_, _, BlobSidecarsT]) verifySidecars(
+	sidecars BlobSidecarsT,
+	kzgOffset uint64,
+	verifyKZGProofs func(BlobSidecarsT) error,
 ) error {
 	var (
 		g, _      = errgroup.WithContext(context.Background())
@@ -83,7 +102,7 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifySidecars(
 
 	// Verify the KZG proofs on the blobs concurrently.
 	g.Go(func() error {
-		return bv.VerifyKZGProofs(sidecars)
+		return verifyKZGProofs(sidecars)
 	})
 
 	g.Go(func() error {
@@ -132,3 +151,26 @@ func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofs(
 		return bv.proofVerifier.VerifyBlobProofBatch(kzg.ArgsFromSidecars(scs))
 	}
 }
+
+// VerifyKZGProofsBatch verifies the KZG proofs of the sidecars in a single
+// batch. Only if the batch fails are the proofs verified one by one, to
+// report which sidecar holds an invalid proof.
+func (bv *Verifier[_, _, BlobSidecarsT]) VerifyKZGProofsBatch(
+	scs BlobSidecarsT,
+) error {
+	err := bv.VerifyKZGProofs(scs)
+	if err == nil || scs.Len() <= 1 {
+		return err
+	}
+
+	for i := range scs.Len() {
+		sc := scs.Get(i)
+		blob := sc.GetBlob()
+		if scErr := bv.proofVerifier.VerifyBlobProof(
+			&blob, sc.GetKzgProof(), sc.GetKzgCommitment(),
+		); scErr != nil {
+			return errors.Wrapf(scErr, "invalid KZG proof for sidecar %d", i)
+		}
+	}
+	return err
+}
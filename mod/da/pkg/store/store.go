@@ -21,7 +21,10 @@
 package store
 
 import (
+	"cmp"
 	"context"
+	"slices"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/da/pkg/types"
 	"github.com/berachain/beacon-kit/mod/errors"
@@ -71,15 +74,86 @@ func (s *Store[BeaconBlockBodyT]) IsDataAvailable(
 	return true
 }
 
+// MissingBlobIndices returns the indices of the KZG commitments in the block
+// for which no sidecar is stored, so that exactly those can be requested from
+// peers.
+func (s *Store[BeaconBlockBodyT]) MissingBlobIndices(
+	_ context.Context,
+	slot math.Slot,
+	body BeaconBlockBodyT,
+) ([]uint64, error) {
+	var missing []uint64
+	for i, commitment := range body.GetBlobKzgCommitments() {
+		stored, err := s.IndexDB.Has(slot.Unwrap(), commitment[:])
+		if err != nil {
+			return nil, err
+		}
+		if !stored {
+			missing = append(missing, uint64(i))
+		}
+	}
+	return missing, nil
+}
+
+// GetBlobSidecarsRange returns the blob sidecars stored for every slot in the
+// range [start, end). The sidecars of a slot are ordered by index, and slots
+// without sidecars, including pruned slots, are omitted.
+func (s *Store[_]) GetBlobSidecarsRange(
+	start, end math.Slot,
+) (map[math.Slot]*types.BlobSidecars, error) {
+	values, err := s.IndexDB.GetRange(start.Unwrap(), end.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+
+	sidecars := make(map[math.Slot]*types.BlobSidecars, len(values))
+	for index, bzs := range values {
+		scs := &types.BlobSidecars{
+			Sidecars: make([]*types.BlobSidecar, 0, len(bzs)),
+		}
+		for _, bz := range bzs {
+			sc := new(types.BlobSidecar)
+			if err = sc.UnmarshalSSZ(bz); err != nil {
+				return nil, err
+			}
+			scs.Sidecars = append(scs.Sidecars, sc)
+		}
+		slices.SortFunc(scs.Sidecars, func(a, b *types.BlobSidecar) int {
+			return cmp.Compare(a.Index, b.Index)
+		})
+		sidecars[math.Slot(index)] = scs
+	}
+	return sidecars, nil
+}
+
+// PersistStats describes the sidecars written by a call to PersistWithStats.
+type PersistStats struct {
+	// NumSidecars is the number of sidecars written.
+	NumSidecars int
+	// BytesWritten is the total size of the sidecars written, in bytes.
+	BytesWritten uint64
+}
+
 // Persist ensures the sidecar data remains accessible, utilizing parallel
 // processing for efficiency.
 func (s *Store[BeaconBlockT]) Persist(
 	slot math.Slot,
 	sidecars *types.BlobSidecars,
 ) error {
+	_, err := s.PersistWithStats(slot, sidecars)
+	return err
+}
+
+// PersistWithStats persists the sidecars like Persist and reports how much was
+// written. Nothing is written, and empty stats are returned, if there are no
+// sidecars or they are outside the DA period.
+func (s *Store[BeaconBlockT]) PersistWithStats(
+	slot math.Slot,
+	sidecars *types.BlobSidecars,
+) (PersistStats, error) {
 	// Exit early if there are no sidecars to store.
 	if sidecars.IsNil() || sidecars.Len() == 0 {
-		return nil
+		return PersistStats{}, nil
 	}
 
 	// Check to see if we are required to store the sidecar anymore, if
@@ -91,10 +165,11 @@ func (s *Store[BeaconBlockT]) Persist(
 		// current slot
 		slot,
 	) {
-		return nil
+		return PersistStats{}, nil
 	}
 
 	// Store each sidecar in parallel.
+	var bytesWritten atomic.Uint64
 	if err := errors.Join(iter.Map(
 		sidecars.Sidecars,
 		func(sidecar **types.BlobSidecar) error {
@@ -106,14 +181,23 @@ func (s *Store[BeaconBlockT]) Persist(
 			if err != nil {
 				return err
 			}
-			return s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz)
+			if err = s.Set(slot.Unwrap(), sc.KzgCommitment[:], bz); err != nil {
+				return err
+			}
+			bytesWritten.Add(uint64(len(bz)))
+			return nil
 		},
 	)...); err != nil {
-		return err
+		return PersistStats{}, err
 	}
 
+	stats := PersistStats{
+		NumSidecars:  sidecars.Len(),
+		BytesWritten: bytesWritten.Load(),
+	}
 	s.logger.Info("Successfully stored all blob sidecars 🚗",
-		"slot", slot.Base10(), "num_sidecars", sidecars.Len(),
+		"slot", slot.Base10(), "num_sidecars", stats.NumSidecars,
+		"bytes_written", stats.BytesWritten,
 	)
-	return nil
+	return stats, nil
 }
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package store_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/da/pkg/store"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/stretchr/testify/require"
+)
+
+// hasIndexDB is an IndexDB that only answers Has, from a set of keys.
+type hasIndexDB struct {
+	store.IndexDB
+	keys map[string]struct{}
+}
+
+func (db hasIndexDB) Has(_ uint64, key []byte) (bool, error) {
+	_, ok := db.keys[string(key)]
+	return ok, nil
+}
+
+type kzgCommitments = eip4844.KZGCommitments[common.ExecutionHash]
+
+// MockBeaconBlockBody is a block body with the given commitments.
+type MockBeaconBlockBody struct {
+	commitments kzgCommitments
+}
+
+func (b MockBeaconBlockBody) GetBlobKzgCommitments() kzgCommitments {
+	return b.commitments
+}
+
+func TestMissingBlobIndices(t *testing.T) {
+	commitments := kzgCommitments{
+		{0x01}, {0x02}, {0x03},
+	}
+	db := hasIndexDB{keys: map[string]struct{}{
+		string(commitments[1][:]): {},
+	}}
+	s := store.New[MockBeaconBlockBody](db, noop.NewLogger[any](), nil)
+
+	missing, err := s.MissingBlobIndices(
+		context.Background(), 1, MockBeaconBlockBody{commitments},
+	)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 2}, missing)
+
+	// All sidecars are stored.
+	db.keys[string(commitments[0][:])] = struct{}{}
+	db.keys[string(commitments[2][:])] = struct{}{}
+	missing, err = s.MissingBlobIndices(
+		context.Background(), 1, MockBeaconBlockBody{commitments},
+	)
+	require.NoError(t, err)
+	require.Empty(t, missing)
+}
@@ -40,6 +40,9 @@ type BlockEvent[BeaconBlockT BeaconBlock] interface {
 type IndexDB interface {
 	Has(index uint64, key []byte) (bool, error)
 	Set(index uint64, key []byte, value []byte) error
+	SetBatch(index uint64, kvs []struct{ Key, Value []byte }) error
+	GetRange(start uint64, end uint64) (map[uint64][][]byte, error)
+	HasAny(start uint64, end uint64) (bool, error)
 	Prune(start uint64, end uint64) error
 }
 
@@ -102,5 +102,31 @@ type PayloadStatusV1 struct {
 	ValidationError *string `json:"validationError"`
 }
 
+// ExecutionPayloadBodyV1 represents the body of an execution payload as per
+// the EngineAPI Specification. For more details, see:
+// https://github.com/ethereum/execution-apis/blob/main/src/engine/shanghai.md#executionpayloadbodyv1
+//
+//nolint:lll // link.
+type ExecutionPayloadBodyV1 struct {
+	// Transactions is the list of transactions in the payload.
+	Transactions []bytes.Bytes `json:"transactions"`
+	// Withdrawals is the list of withdrawals in the payload.
+	Withdrawals []*Withdrawal `json:"withdrawals"`
+}
+
+// GetTransactions returns the transactions of the payload body.
+func (b *ExecutionPayloadBodyV1) GetTransactions() Transactions {
+	txs := make(Transactions, len(b.Transactions))
+	for i, tx := range b.Transactions {
+		txs[i] = tx
+	}
+	return txs
+}
+
+// GetWithdrawals returns the withdrawals of the payload body.
+func (b *ExecutionPayloadBodyV1) GetWithdrawals() Withdrawals {
+	return b.Withdrawals
+}
+
 // PayloadID is an identifier for the payload build process.
 type PayloadID = bytes.B8
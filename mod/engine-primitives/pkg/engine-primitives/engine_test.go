@@ -96,3 +96,38 @@ func TestPayloadStatusV1(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, status, &unmarshaledStatus)
 }
+
+func TestExecutionPayloadBodiesV1(t *testing.T) {
+	input := `[
+		{
+			"transactions": ["0x0102", "0x03"],
+			"withdrawals": [{
+				"index": "0x1",
+				"validatorIndex": "0x2",
+				"address": "0x0000000000000000000000000000000000000001",
+				"amount": "0x3"
+			}]
+		},
+		null
+	]`
+
+	var bodies []*engineprimitives.ExecutionPayloadBodyV1
+	err := json.Unmarshal([]byte(input), &bodies)
+	require.NoError(t, err)
+	require.Len(t, bodies, 2)
+
+	require.Equal(
+		t,
+		engineprimitives.Transactions{{0x1, 0x2}, {0x3}},
+		bodies[0].GetTransactions(),
+	)
+	require.Len(t, bodies[0].GetWithdrawals(), 1)
+	require.Equal(
+		t,
+		common.ExecutionAddress{19: 0x1},
+		bodies[0].GetWithdrawals()[0].GetAddress(),
+	)
+
+	// Blocks unknown to the execution client are null entries.
+	require.Nil(t, bodies[1])
+}
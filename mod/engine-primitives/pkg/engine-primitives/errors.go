@@ -30,6 +30,16 @@ var (
 	// Capella versioned payload.
 	ErrNilWithdrawals = errors.New("nil withdrawals post capella")
 
+	// ErrWithdrawalsExceedLimit indicates that the number of withdrawals
+	// exceeds the list limit.
+	ErrWithdrawalsExceedLimit = errors.New(
+		"number of withdrawals exceeds limit",
+	)
+
+	// ErrZeroWithdrawalAddress indicates that a withdrawal has an empty
+	// execution address.
+	ErrZeroWithdrawalAddress = errors.New("zero withdrawal address")
+
 	// ErrEmptyPrevRandao indicates that the previous RANDAO value is empty.
 	ErrEmptyPrevRandao = errors.New("empty randao")
 
@@ -171,6 +171,14 @@ func (w *Withdrawal) Equals(other *Withdrawal) bool {
 		w.Amount == other.Amount
 }
 
+// Validate returns an error if the withdrawal has an empty execution address.
+func (w *Withdrawal) Validate() error {
+	if w.Address == (common.ExecutionAddress{}) {
+		return ErrZeroWithdrawalAddress
+	}
+	return nil
+}
+
 // GetIndex returns the unique identifier for the withdrawal.
 func (w *Withdrawal) GetIndex() math.U64 {
 	return w.Index
@@ -75,6 +75,35 @@ func TestWithdrawal_Equals(t *testing.T) {
 	require.False(t, withdrawal1.Equals(withdrawal3))
 }
 
+func TestWithdrawal_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		address common.ExecutionAddress
+		wantErr error
+	}{
+		{
+			name:    "Valid address",
+			address: common.ExecutionAddress{1, 2, 3, 4, 5},
+		},
+		{
+			name:    "Zero address",
+			address: common.ExecutionAddress{},
+			wantErr: engineprimitives.ErrZeroWithdrawalAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &engineprimitives.Withdrawal{
+				Index:     math.U64(1),
+				Validator: math.ValidatorIndex(1),
+				Address:   tt.address,
+				Amount:    math.Gwei(1000),
+			}
+			require.ErrorIs(t, w.Validate(), tt.wantErr)
+		})
+	}
+}
+
 func TestWithdrawalMethods(t *testing.T) {
 	withdrawal := &engineprimitives.Withdrawal{
 		Index:     math.U64(1),
@@ -23,9 +23,11 @@ package engineprimitives
 import (
 	"bytes"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	sszutil "github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz"
 	"github.com/karalabe/ssz"
 )
 
@@ -68,6 +70,24 @@ func (w Withdrawals) HashTreeRoot() common.Root {
 	return ssz.HashSequential(w)
 }
 
+// WithdrawalsRoot returns the hash tree root of the withdrawals as an SSZ
+// list bounded by limit. Unlike HashTreeRoot, it returns an error if the
+// withdrawals exceed the limit instead of an incorrect root.
+func WithdrawalsRoot(
+	withdrawals Withdrawals,
+	limit uint64,
+) (common.Root, error) {
+	count := uint64(len(withdrawals))
+	if count > limit {
+		return common.Root{}, errors.Wrapf(
+			ErrWithdrawalsExceedLimit,
+			"got %d withdrawals, limit is %d", count, limit,
+		)
+	}
+
+	return sszutil.ListRootWithLimit(withdrawals, limit)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                     RLP                                    */
 /* -------------------------------------------------------------------------- */
@@ -21,6 +21,7 @@
 package engineprimitives_test
 
 import (
+	"encoding/binary"
 	"testing"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
@@ -102,3 +103,115 @@ func TestWithdrawals(t *testing.T) {
 		require.NotEqual(t, emptyRoot, nonEmptyRoot)
 	})
 }
+
+func TestWithdrawalsRoot(t *testing.T) {
+	const limit = 16
+	newWithdrawals := func(n int) engineprimitives.Withdrawals {
+		withdrawals := make(engineprimitives.Withdrawals, n)
+		for i := range withdrawals {
+			withdrawals[i] = &engineprimitives.Withdrawal{
+				Index:     math.U64(i),
+				Validator: math.ValidatorIndex(i),
+				Address:   common.ExecutionAddress{byte(i)},
+				Amount:    math.Gwei(100 * i),
+			}
+		}
+		return withdrawals
+	}
+
+	for _, n := range []int{0, 1, 5, limit} {
+		withdrawals := newWithdrawals(n)
+		root, err := engineprimitives.WithdrawalsRoot(withdrawals, limit)
+		require.NoError(t, err)
+		require.Equal(t, withdrawals.HashTreeRoot(), root)
+	}
+
+	_, err := engineprimitives.WithdrawalsRoot(newWithdrawals(limit+1), limit)
+	require.ErrorIs(t, err, engineprimitives.ErrWithdrawalsExceedLimit)
+}
+
+// TestWithdrawalsHashTreeRootGoldenVectors checks the withdrawals root
+// against vectors computed independently from the SSZ specification for a
+// List[Withdrawal, MAX_WITHDRAWALS_PER_PAYLOAD].
+func TestWithdrawalsHashTreeRootGoldenVectors(t *testing.T) {
+	full := make(engineprimitives.Withdrawals, 16)
+	for i := range full {
+		var addr common.ExecutionAddress
+		binary.BigEndian.PutUint64(addr[12:], uint64(0xabcdef*(i+1)))
+		full[i] = &engineprimitives.Withdrawal{
+			Index:     math.U64(100 + i),
+			Validator: math.ValidatorIndex(200000 + i),
+			Address:   addr,
+			Amount:    math.Gwei(1000000 + i*7),
+		}
+	}
+
+	tests := []struct {
+		name        string
+		withdrawals engineprimitives.Withdrawals
+		expected    string
+	}{
+		{
+			name:        "empty",
+			withdrawals: engineprimitives.Withdrawals{},
+			expected:    "0x792930bbd5baac43bcc798ee49aa8185ef76bb3b44ba62b91d86ae569e4bb535",
+		},
+		{
+			name: "single",
+			withdrawals: engineprimitives.Withdrawals{
+				{
+					Index:     15891534,
+					Validator: 434172,
+					Address: common.NewExecutionAddressFromHex(
+						"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f",
+					),
+					Amount: 17442123,
+				},
+			},
+			expected: "0xeb98f7d3e7fb3c9d773b36f124b68c9ce678e82f2c4664ae92582798d39c7f54",
+		},
+		{
+			name: "multiple",
+			withdrawals: engineprimitives.Withdrawals{
+				{
+					Index:     15891534,
+					Validator: 434172,
+					Address: common.NewExecutionAddressFromHex(
+						"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f",
+					),
+					Amount: 17442123,
+				},
+				{
+					Index:     15891535,
+					Validator: 434173,
+					Address: common.NewExecutionAddressFromHex(
+						"0xb9d7934878b5fb9610b3fe8a5e441e8fad7e293f",
+					),
+					Amount: 17397011,
+				},
+				{
+					Index:     15891536,
+					Validator: 434174,
+					Address: common.NewExecutionAddressFromHex(
+						"0x388c818ca8b9251b393131c08a736a67ccb19297",
+					),
+					Amount: 32016829123,
+				},
+			},
+			expected: "0x152fde300ee28c6428c77cd46ab0ed8ff05924600509a52f861f5402e2478d18",
+		},
+		{
+			name:        "full",
+			withdrawals: full,
+			expected:    "0x18e2d48f31686745a47f50c0c825307b40f2ef9510a410f330e5b8289366471f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected, err := common.NewRootFromHex(tt.expected)
+			require.NoError(t, err)
+			require.Equal(t, expected, tt.withdrawals.HashTreeRoot())
+		})
+	}
+}
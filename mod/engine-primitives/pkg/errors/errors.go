@@ -93,6 +93,12 @@ var (
 		"nil payload status received from execution client",
 	)
 
+	// ErrTooManyPayloadBodies is returned when more payload bodies than
+	// requested are received.
+	ErrTooManyPayloadBodies = errors.New(
+		"too many payload bodies received from execution client",
+	)
+
 	// ErrEngineAPITimeout is returned when the engine API call times out.
 	ErrEngineAPITimeout = errors.New(
 		"engine API call timed out",
@@ -32,6 +32,8 @@ const (
 	defaultRPCTimeout              = 2 * time.Second
 	defaultRPCStartupCheckInterval = 3 * time.Second
 	defaultRPCJWTRefreshInterval   = 20 * time.Second
+	defaultFCURetries              = 3
+	defaultFCURetryBaseDelay       = 100 * time.Millisecond
 	//#nosec:G101 // false positive.
 	defaultJWTSecretPath = "./jwt.hex"
 )
@@ -47,6 +49,8 @@ func DefaultConfig() Config {
 		RPCStartupCheckInterval: defaultRPCStartupCheckInterval,
 		RPCJWTRefreshInterval:   defaultRPCJWTRefreshInterval,
 		JWTSecretPath:           defaultJWTSecretPath,
+		FCURetries:              defaultFCURetries,
+		FCURetryBaseDelay:       defaultFCURetryBaseDelay,
 	}
 }
 
@@ -67,4 +71,10 @@ type Config struct {
 	RPCJWTRefreshInterval time.Duration `mapstructure:"rpc-jwt-refresh-interval"`
 	// JWTSecretPath is the path to the JWT secret.
 	JWTSecretPath string `mapstructure:"jwt-secret-path"`
+	// FCURetries is the number of times a forkchoice update is retried on
+	// connection errors.
+	FCURetries uint64 `mapstructure:"fcu-retries"`
+	// FCURetryBaseDelay is the delay before the first forkchoice update
+	// retry, doubled on every subsequent retry.
+	FCURetryBaseDelay time.Duration `mapstructure:"fcu-retry-base-delay"`
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 	ethclient "github.com/berachain/beacon-kit/mod/execution/pkg/client/ethclient"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
 /* -------------------------------------------------------------------------- */
@@ -166,6 +167,36 @@ func (s *EngineClient[
 	return result, nil
 }
 
+/* -------------------------------------------------------------------------- */
+/*                           GetPayloadBodiesByRange                          */
+/* -------------------------------------------------------------------------- */
+
+// GetPayloadBodiesByRange calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. It returns the payload bodies of the count blocks starting at
+// the start block number. Blocks unknown to the execution client are returned
+// as nil entries, and the result is shorter than count if the range extends
+// past the latest block of the execution client.
+func (s *EngineClient[
+	_, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start, count uint64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	cctx, cancel := s.createContextWithTimeout(ctx)
+	defer cancel()
+
+	result, err := s.Client.GetPayloadBodiesByRangeV1(
+		cctx, math.U64(start), math.U64(count),
+	)
+	if err != nil {
+		return nil, s.handleRPCError(err)
+	}
+	if uint64(len(result)) > count {
+		return nil, engineerrors.ErrTooManyPayloadBodies
+	}
+	return result, nil
+}
+
 // ExchangeCapabilities calls the engine_exchangeCapabilities method via
 // JSON-RPC.
 func (s *EngineClient[
@@ -27,6 +27,7 @@ func BeaconKitSupportedCapabilities() []string {
 		NewPayloadMethodV3,
 		ForkchoiceUpdatedMethodV3,
 		GetPayloadMethodV3,
+		GetPayloadBodiesByRangeMethodV1,
 		GetClientVersionV1,
 	}
 }
@@ -39,6 +40,9 @@ const (
 	ForkchoiceUpdatedMethodV3 = "engine_forkchoiceUpdatedV3"
 	// GetPayloadMethodV3 for retrieving a payload in Deneb.
 	GetPayloadMethodV3 = "engine_getPayloadV3"
+	// GetPayloadBodiesByRangeMethodV1 for retrieving the payload bodies of a
+	// range of blocks.
+	GetPayloadBodiesByRangeMethodV1 = "engine_getPayloadBodiesByRangeV1"
 	// BlockByHashMethod for retrieving a block by its hash.
 	BlockByHashMethod = "eth_getBlockByHash"
 	// BlockByNumberMethod for retrieving a block by its number.
@@ -26,6 +26,7 @@ import (
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/version"
 )
 
@@ -156,6 +157,21 @@ func (s *Client[ExecutionPayloadT]) GetPayloadV3(
 	return result, nil
 }
 
+// GetPayloadBodiesByRangeV1 calls the engine_getPayloadBodiesByRangeV1 method
+// via JSON-RPC. Blocks unknown to the execution client are returned as nil
+// entries.
+func (s *Client[ExecutionPayloadT]) GetPayloadBodiesByRangeV1(
+	ctx context.Context, start, count math.U64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	result := make([]*engineprimitives.ExecutionPayloadBodyV1, 0)
+	if err := s.Call(
+		ctx, &result, GetPayloadBodiesByRangeMethodV1, start, count,
+	); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Other                                   */
 /* -------------------------------------------------------------------------- */
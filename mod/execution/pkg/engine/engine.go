@@ -106,6 +106,18 @@ func (ee *Engine[
 	)
 }
 
+// GetPayloadBodiesByRange returns the payload bodies of the count blocks
+// starting at the start block number. Blocks unknown to the execution client
+// are returned as nil entries.
+func (ee *Engine[
+	_, _, _, _,
+]) GetPayloadBodiesByRange(
+	ctx context.Context,
+	start, count uint64,
+) ([]*engineprimitives.ExecutionPayloadBodyV1, error) {
+	return ee.ec.GetPayloadBodiesByRange(ctx, start, count)
+}
+
 // NotifyForkchoiceUpdate notifies the execution client of a forkchoice update.
 func (ee *Engine[
 	_, PayloadAttributesT, _, _,
@@ -189,6 +201,23 @@ func (ee *Engine[
 		ExecutionPayloadT, WithdrawalsT,
 	],
 ) error {
+	_, err := ee.VerifyAndNotifyNewPayloadWithStatus(ctx, req)
+	return err
+}
+
+// VerifyAndNotifyNewPayloadWithStatus verifies the new payload and notifies
+// the execution client, like VerifyAndNotifyNewPayload. It also returns the
+// payload status of the execution client, so that SYNCING and ACCEPTED can be
+// told apart from VALID. The status is empty if the execution client did not
+// return one.
+func (ee *Engine[
+	ExecutionPayloadT, _, _, WithdrawalsT,
+]) VerifyAndNotifyNewPayloadWithStatus(
+	ctx context.Context,
+	req *engineprimitives.NewPayloadRequest[
+		ExecutionPayloadT, WithdrawalsT,
+	],
+) (engineprimitives.PayloadStatusStr, error) {
 	// Log the new payload attempt.
 	ee.metrics.markNewPayloadCalled(
 		req.ExecutionPayload.GetBlockHash(),
@@ -201,7 +230,7 @@ func (ee *Engine[
 	// TODO: is this required? Or will the EL handle this for us during
 	// new payload?
 	if err := req.HasValidVersionedAndBlockHashes(); err != nil {
-		return err
+		return "", err
 	}
 
 	// Otherwise we will send the payload to the execution client.
@@ -246,7 +275,7 @@ func (ee *Engine[
 		// if we are running in optimistic mode or not.
 		//
 		// TODO: should we still nillify the error in optimistic mode?
-		return ErrBadBlockProduced
+		return payloadStatusFromError(err), ErrBadBlockProduced
 
 	case jsonrpc.IsPreDefinedError(err):
 		// Protect against possible nil value.
@@ -276,6 +305,8 @@ func (ee *Engine[
 		)
 	}
 
+	status := payloadStatusFromError(err)
+
 	// Under the optimistic condition, we are fine ignoring the error. This
 	// is mainly to allow us to safely call the execution client
 	// during abci.FinalizeBlock. If we are in abci.FinalizeBlock and
@@ -292,7 +323,28 @@ func (ee *Engine[
 	// it would cause a failure of abci.FinalizeBlock and a
 	// "CONSENSUS FAILURE!!!!" at the CometBFT layer.
 	if req.Optimistic {
-		return nil
+		return status, nil
+	}
+	return status, err
+}
+
+// payloadStatusFromError returns the payload status the execution client
+// responded with, given the error returned by the engine client.
+func payloadStatusFromError(err error) engineprimitives.PayloadStatusStr {
+	switch {
+	case err == nil:
+		return engineprimitives.PayloadStatusValid
+	case errors.Is(err, engineerrors.ErrAcceptedPayloadStatus):
+		return engineprimitives.PayloadStatusAccepted
+	case errors.Is(err, engineerrors.ErrSyncingPayloadStatus):
+		return engineprimitives.PayloadStatusSyncing
+	case errors.IsAny(
+		err,
+		engineerrors.ErrInvalidPayloadStatus,
+		engineerrors.ErrInvalidBlockHashPayloadStatus,
+	):
+		return engineprimitives.PayloadStatusInvalid
+	default:
+		return ""
 	}
-	return err
 }
@@ -32,14 +32,19 @@ type Formatter struct {
 	keyColors map[string]Color
 	// KeyValColors applies specific colors to log entries based on their keys
 	// and values.
-	keyValColors map[string]Color
+	keyValColors map[keyVal]Color
+}
+
+// keyVal is a key-value pair of a log entry.
+type keyVal struct {
+	key, val string
 }
 
 // NewFormatter creates a new Formatter with default settings.
 func NewFormatter() *Formatter {
 	return &Formatter{
 		keyColors:    make(map[string]Color),
-		keyValColors: make(map[string]Color),
+		keyValColors: make(map[keyVal]Color),
 	}
 }
 
@@ -96,7 +101,7 @@ func (f *Formatter) AddKeyColor(key string, color Color) {
 
 // AddKeyValColor adds a key and color to the keyValColors map.
 func (f *Formatter) AddKeyValColor(key string, val string, color Color) {
-	f.keyValColors[key+val] = color
+	f.keyValColors[keyVal{key: key, val: val}] = color
 }
 
 // printWithColor prints the log message with color.
@@ -117,7 +122,9 @@ func (f *Formatter) printWithColor(
 	for _, kv := range args.KeyValues {
 		b.Bytes = append(b.Bytes, ' ')
 		// apply the key+value color if configured, otherwise apply key color
-		if kvColor, ok = f.keyValColors[kv.Key+kv.Value]; ok {
+		if kvColor, ok = f.keyValColors[keyVal{
+			key: kv.Key, val: kv.Value,
+		}]; ok {
 			b.Bytes = append(b.Bytes, kvColor.Raw()...)
 		} else if kColor, ok = f.keyColors[kv.Key]; ok {
 			b.Bytes = append(b.Bytes, kColor.Raw()...)
@@ -21,11 +21,16 @@
 package phuslu
 
 import (
+	"fmt"
 	"io"
 
 	"github.com/phuslu/log"
 )
 
+// badKey is the key under which the dangling value of an odd-length list of
+// key-value pairs is logged.
+const badKey = "!BADKEY"
+
 // Logger is a wrapper around phuslogger.
 type Logger struct {
 	// logger is the underlying logger implementation.
@@ -101,13 +106,9 @@ func (l Logger) With(keyVals ...any) *Logger {
 	}
 
 	// Add the new context to the existing context.
-	for i := 0; i < len(keyVals); i += 2 {
-		key, ok := keyVals[i].(string)
-		if !ok {
-			continue
-		}
-		newLogger.context[key] = keyVals[i+1]
-	}
+	forEachKeyVal(keyVals, func(key string, val any) {
+		newLogger.context[key] = val
+	})
 
 	return &newLogger
 }
@@ -121,7 +122,28 @@ func (l *Logger) Writer() io.Writer {
 func (l *Logger) msgWithContext(
 	msg string, e *log.Entry, keyVals ...any,
 ) {
-	e.Fields(l.context).KeysAndValues(keyVals...).Msg(msg)
+	e = e.Fields(l.context)
+	forEachKeyVal(keyVals, func(key string, val any) {
+		e = e.Any(key, val)
+	})
+	e.Msg(msg)
+}
+
+// forEachKeyVal calls fn on each adjacent key-value pair of keyVals. Keys that
+// are not strings are formatted with fmt, and the dangling value of an
+// odd-length list is paired with badKey.
+func forEachKeyVal(keyVals []any, fn func(key string, val any)) {
+	for i := 0; i < len(keyVals); i += 2 {
+		if i+1 == len(keyVals) {
+			fn(badKey, keyVals[i])
+			return
+		}
+		key, ok := keyVals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyVals[i])
+		}
+		fn(key, keyVals[i+1])
+	}
 }
 
 /* -------------------------------------------------------------------------- */
@@ -142,15 +164,16 @@ func (l *Logger) WithConfig(cfg *Config) *Logger {
 	return l
 }
 
-// AddKeyColor applies a color to log entries based on their keys.
+// AddKeyColor applies a color to log entries based on their keys. Keys that
+// are not strings are formatted with fmt, as when they are logged.
 func (l *Logger) AddKeyColor(key any, color Color) {
-	l.formatter.AddKeyColor(key.(string), color)
+	l.formatter.AddKeyColor(fmt.Sprint(key), color)
 }
 
 // AddKeyValColor applies specific colors to log entries based on their keys and
-// values.
+// values. Keys and values are matched by their fmt formatting.
 func (l *Logger) AddKeyValColor(key any, val any, color Color) {
-	l.formatter.AddKeyValColor(key.(string), val.(string), color)
+	l.formatter.AddKeyValColor(fmt.Sprint(key), fmt.Sprint(val), color)
 }
 
 // sets the style of the logger.
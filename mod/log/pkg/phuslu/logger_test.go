@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package phuslu_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/log"
+	"github.com/berachain/beacon-kit/mod/log/pkg/phuslu"
+)
+
+func TestLoggerKeyVals(t *testing.T) {
+	tests := []struct {
+		name    string
+		with    []any
+		keyVals []any
+		want    map[string]any
+	}{
+		{
+			name:    "Pairs",
+			keyVals: []any{"a", "1", "b", "2"},
+			want:    map[string]any{"a": "1", "b": "2"},
+		},
+		{
+			name:    "Dangling value",
+			keyVals: []any{"a", "1", "2"},
+			want:    map[string]any{"a": "1", "!BADKEY": "2"},
+		},
+		{
+			name:    "Non-string key",
+			keyVals: []any{7, "1"},
+			want:    map[string]any{"7": "1"},
+		},
+		{
+			name:    "Context with dangling value",
+			with:    []any{"a", "1", "2"},
+			keyVals: []any{"b", "3"},
+			want:    map[string]any{"a": "1", "!BADKEY": "2", "b": "3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			cfg := phuslu.DefaultConfig()
+			cfg.Style = phuslu.StyleJSON
+			logger := phuslu.NewLogger(&buf, &cfg)
+			if tt.with != nil {
+				logger = logger.With(tt.with...)
+			}
+			logger.Info("msg", tt.keyVals...)
+
+			var got map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("Failed to decode log entry: %v", err)
+			}
+			for k, v := range tt.want {
+				if got[k] != v {
+					t.Errorf("Expected %s=%v, but got %v", k, v, got[k])
+				}
+			}
+			if _, ok := got[""]; ok {
+				t.Error("Expected no field with an empty key")
+			}
+		})
+	}
+}
+
+func TestLoggerKeyColors(t *testing.T) {
+	var buf bytes.Buffer
+	cfg := phuslu.DefaultConfig()
+	logger := phuslu.NewLogger(&buf, &cfg)
+	logger.AddKeyColor("a", log.Red)
+	logger.AddKeyValColor("b", 2, log.Blue)
+	logger.AddKeyValColor("c", "4", log.Cyan)
+	logger.Info("msg", "a", "1", "b", 2, "c", "3")
+
+	out := buf.String()
+	for _, want := range []string{
+		log.Red.Raw() + "a=1",
+		log.Blue.Raw() + "b=2",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in %q", want, out)
+		}
+	}
+	if strings.Contains(out, log.Cyan.Raw()) {
+		t.Errorf("Expected no key-value color for c=3 in %q", out)
+	}
+}
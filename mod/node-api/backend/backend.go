@@ -23,8 +23,10 @@ package backend
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 // Backend is the db access layer for the beacon node-api.
@@ -56,7 +58,10 @@ type Backend[
 		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
-	ValidatorsT ~[]ValidatorT,
+	ValidatorsT interface {
+		~[]ValidatorT
+		HashTreeRoot() common.Root
+	},
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ] struct {
@@ -65,9 +70,22 @@ type Backend[
 	node NodeT
 
 	sp StateProcessor[BeaconStateT]
+
+	// readOnlyBlocks is an optional read-only block store serving the block
+	// lookups in place of the block store of the storage backend.
+	readOnlyBlocks BlockStore[BeaconBlockT]
+
+	// archive is an optional secondary block store consulted for block roots
+	// that were pruned from the primary block store.
+	archive BlockStore[BeaconBlockT]
+
+	// queryContexts caches query contexts by height and proof flag. It is nil
+	// when query context caching is disabled.
+	queryContexts *lru.Cache[queryContextKey, ContextT]
 }
 
-// New creates and returns a new Backend instance.
+// New creates and returns a new Backend instance. A positive
+// queryContextCacheSize enables caching of up to that many query contexts.
 func New[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
@@ -94,21 +112,25 @@ func New[
 		AvailabilityStoreT, BeaconStateT, BlockStoreT, DepositStoreT,
 	],
 	ValidatorT Validator[WithdrawalCredentialsT],
-	ValidatorsT ~[]ValidatorT,
+	ValidatorsT interface {
+		~[]ValidatorT
+		HashTreeRoot() common.Root
+	},
 	WithdrawalT Withdrawal[WithdrawalT],
 	WithdrawalCredentialsT WithdrawalCredentials,
 ](
 	storageBackend StorageBackendT,
 	cs common.ChainSpec,
 	sp StateProcessor[BeaconStateT],
-) *Backend[
+	queryContextCacheSize int,
+) (*Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 	ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
 	NodeT, StateStoreT, StorageBackendT, ValidatorT, ValidatorsT, WithdrawalT,
 	WithdrawalCredentialsT,
-] {
-	return &Backend[
+], error) {
+	b := &Backend[
 		AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 		BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BlockStoreT,
 		ContextT, DepositT, DepositStoreT, Eth1DataT, ExecutionPayloadHeaderT, ForkT,
@@ -119,6 +141,16 @@ func New[
 		cs: cs,
 		sp: sp,
 	}
+	if queryContextCacheSize > 0 {
+		var err error
+		b.queryContexts, err = lru.New[queryContextKey, ContextT](
+			queryContextCacheSize,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
 }
 
 // AttachQueryBackend sets the node on the backend for
@@ -129,6 +161,33 @@ func (b *Backend[
 	b.node = node
 }
 
+// AttachArchiveBlockStore sets the archival block store consulted when a block
+// root is not found in the primary block store.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttachArchiveBlockStore(archive BlockStore[BeaconBlockT]) {
+	b.archive = archive
+}
+
+// AttachReadOnlyBlockStore sets the read-only block store used for the block
+// lookups, so that they do not go through the block store of the storage
+// backend written to by consensus.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) AttachReadOnlyBlockStore(blocks BlockStore[BeaconBlockT]) {
+	b.readOnlyBlocks = blocks
+}
+
+// blockStore returns the block store serving the block lookups.
+func (b *Backend[
+	_, BeaconBlockT, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) blockStore() BlockStore[BeaconBlockT] {
+	if b.readOnlyBlocks != nil {
+		return b.readOnlyBlocks
+	}
+	return b.sb.BlockStore()
+}
+
 // ChainSpec returns the chain spec from the backend.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, NodeT, _, _, _, _, _, _,
@@ -136,18 +195,54 @@ func (b *Backend[
 	return b.cs
 }
 
-// GetSlotByBlockRoot retrieves the slot by a block root from the block store.
+// GetSlotByBlockRoot retrieves the slot by a block root from the block store,
+// falling back to the archival block store if one is attached.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByBlockRoot(root common.Root) (math.Slot, error) {
-	return b.sb.BlockStore().GetSlotByBlockRoot(root)
+	slot, err := b.blockStore().GetSlotByBlockRoot(root)
+	if err == nil {
+		return slot, nil
+	}
+	if b.archive != nil {
+		if slot, err = b.archive.GetSlotByBlockRoot(root); err == nil {
+			return slot, nil
+		}
+	}
+	return 0, errors.Wrapf(ErrRootNotIndexed, "block root %s", root)
+}
+
+// GetSlotsByBlockRoots retrieves the slots by the given block roots from the
+// block store.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetSlotsByBlockRoots(
+	roots []common.Root,
+) (map[common.Root]math.Slot, error) {
+	return b.blockStore().GetSlotsByBlockRoots(roots)
+}
+
+// GetBlobSidecarsRange retrieves the blob sidecars for the slots in the range
+// [start, end) from the availability store.
+func (b *Backend[
+	_, _, _, _, _, _, BlobSidecarsT, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) GetBlobSidecarsRange(
+	start, end math.Slot,
+) (map[math.Slot]BlobSidecarsT, error) {
+	return b.sb.AvailabilityStore().GetBlobSidecarsRange(start, end)
 }
 
 // GetSlotByStateRoot retrieves the slot by a state root from the block store.
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetSlotByStateRoot(root common.Root) (math.Slot, error) {
-	return b.sb.BlockStore().GetSlotByStateRoot(root)
+	slot, err := b.blockStore().GetSlotByStateRoot(root)
+	if err != nil {
+		return 0, errors.Join(
+			errors.Wrapf(ErrSlotNotFound, "state root %s", root), err,
+		)
+	}
+	return slot, nil
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
@@ -155,7 +250,13 @@ func (b *Backend[
 func (b *Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error) {
-	return b.sb.BlockStore().GetParentSlotByTimestamp(timestamp)
+	slot, err := b.blockStore().GetParentSlotByTimestamp(timestamp)
+	if err != nil {
+		return 0, errors.Join(
+			errors.Wrapf(ErrSlotNotFound, "timestamp %d", timestamp), err,
+		)
+	}
+	return slot, nil
 }
 
 // stateFromSlot returns the state at the given slot, after also processing the
@@ -190,9 +291,11 @@ func (b *Backend[
 ]) stateFromSlotRaw(slot math.Slot) (BeaconStateT, math.Slot, error) {
 	var st BeaconStateT
 	//#nosec:G701 // not an issue in practice.
-	queryCtx, err := b.node.CreateQueryContext(int64(slot), false)
+	queryCtx, err := b.queryContext(int64(slot), false)
 	if err != nil {
-		return st, slot, err
+		return st, slot, errors.Join(
+			errors.Wrapf(ErrStateNotAvailable, "slot %d", slot), err,
+		)
 	}
 	st = b.sb.StateFromContext(queryCtx)
 
@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+import (
+	"fmt"
+
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+)
+
+// The lookup errors below wrap types.ErrNotFound, so that handlers returning
+// them unchanged are answered with a 404 rather than a 500.
+var (
+	// ErrSlotNotFound is returned when no slot is indexed for the requested
+	// block root, state root or timestamp.
+	ErrSlotNotFound = fmt.Errorf("slot %w", types.ErrNotFound)
+
+	// ErrValidatorNotFound is returned when the requested validator is not in
+	// the validator set of the queried state.
+	ErrValidatorNotFound = fmt.Errorf("validator %w", types.ErrNotFound)
+
+	// ErrStateNotAvailable is returned when the state of the requested slot
+	// cannot be loaded, e.g. because it has been pruned.
+	ErrStateNotAvailable = fmt.Errorf("state %w", types.ErrNotFound)
+
+	// ErrRootNotIndexed is returned when a block root is found neither in the
+	// block store nor in the archival block store.
+	ErrRootNotIndexed = errors.Wrap(ErrSlotNotFound, "root not indexed")
+
+	// ErrSlotOutsideHistory is returned when the state root of a slot is no
+	// longer, or not yet, retained in the historical state roots.
+	ErrSlotOutsideHistory = errors.Wrap(
+		ErrStateNotAvailable, "slot outside retained history",
+	)
+)
@@ -190,6 +190,64 @@ func (_c *BlockStore_GetSlotByStateRoot_Call[BeaconBlockT]) RunAndReturn(run fun
 	return _c
 }
 
+// GetSlotsByBlockRoots provides a mock function with given fields: roots
+func (_m *BlockStore[BeaconBlockT]) GetSlotsByBlockRoots(roots []common.Root) (map[common.Root]math.U64, error) {
+	ret := _m.Called(roots)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSlotsByBlockRoots")
+	}
+
+	var r0 map[common.Root]math.U64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]common.Root) (map[common.Root]math.U64, error)); ok {
+		return rf(roots)
+	}
+	if rf, ok := ret.Get(0).(func([]common.Root) map[common.Root]math.U64); ok {
+		r0 = rf(roots)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[common.Root]math.U64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]common.Root) error); ok {
+		r1 = rf(roots)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BlockStore_GetSlotsByBlockRoots_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSlotsByBlockRoots'
+type BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT any] struct {
+	*mock.Call
+}
+
+// GetSlotsByBlockRoots is a helper method to define mock.On call
+//   - roots []common.Root
+func (_e *BlockStore_Expecter[BeaconBlockT]) GetSlotsByBlockRoots(roots interface{}) *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT] {
+	return &BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT]{Call: _e.mock.On("GetSlotsByBlockRoots", roots)}
+}
+
+func (_c *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT]) Run(run func(roots []common.Root)) *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]common.Root))
+	})
+	return _c
+}
+
+func (_c *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT]) Return(_a0 map[common.Root]math.U64, _a1 error) *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT] {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT]) RunAndReturn(run func([]common.Root) (map[common.Root]math.U64, error)) *BlockStore_GetSlotsByBlockRoots_Call[BeaconBlockT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewBlockStore creates a new instance of BlockStore. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewBlockStore[BeaconBlockT any](t interface {
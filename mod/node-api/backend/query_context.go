@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package backend
+
+// queryContextKey identifies a cached query context. The proof flag is part of
+// the key so that a context built without proofs is never served to a caller
+// asking for them, and vice versa.
+type queryContextKey struct {
+	height int64
+	prove  bool
+}
+
+// queryContext returns the query context for the given height and proof flag,
+// serving it from the query context cache when enabled. Height 0 resolves to
+// the latest height and is therefore never cached.
+//
+// Callers mutate the state behind the returned context, so when the context
+// supports branching a fresh branch of the cached context is returned on
+// every call.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, ContextT, _, _, _, _, _, _, _, _, _, _, _, _,
+]) queryContext(height int64, prove bool) (ContextT, error) {
+	if b.queryContexts == nil || height == 0 {
+		return b.node.CreateQueryContext(height, prove)
+	}
+
+	key := queryContextKey{height: height, prove: prove}
+	ctx, ok := b.queryContexts.Get(key)
+	if !ok {
+		var err error
+		if ctx, err = b.node.CreateQueryContext(height, prove); err != nil {
+			return ctx, err
+		}
+		b.queryContexts.Add(key, ctx)
+	}
+
+	if brancher, isBrancher := any(ctx).(interface {
+		CacheContext() (ContextT, func())
+	}); isBrancher {
+		ctx, _ = brancher.CacheContext()
+	}
+	return ctx, nil
+}
+
+// InvalidateQueryContextCache drops every cached query context. It should be
+// called whenever a new block is finalized.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) InvalidateQueryContextCache() {
+	if b.queryContexts != nil {
+		b.queryContexts.Purge()
+	}
+}
@@ -21,6 +21,7 @@
 package backend
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -48,6 +49,61 @@ func (b Backend[
 	return st.StateRootAtIndex(slot.Unwrap() % b.cs.SlotsPerHistoricalRoot())
 }
 
+// StateRootsAtSlots returns the state roots of the given slots, in the same
+// order as the input. Slots outside the historical state roots retained by the
+// latest state get a zero root, and an error is accumulated for each of them.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) StateRootsAtSlots(slots []math.Slot) ([]common.Root, error) {
+	st, latest, err := b.stateFromSlot(0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		errs      error
+		root      common.Root
+		roots     = make([]common.Root, len(slots))
+		retention = b.cs.SlotsPerHistoricalRoot()
+	)
+	for i, slot := range slots {
+		if slot > latest || latest.Unwrap()-slot.Unwrap() >= retention {
+			errs = errors.Join(errs, errors.Wrapf(
+				ErrSlotOutsideHistory, "slot %d, latest %d", slot, latest,
+			))
+			continue
+		}
+		if root, err = st.StateRootAtIndex(
+			slot.Unwrap() % retention,
+		); err != nil {
+			return nil, err
+		}
+		roots[i] = root
+	}
+	return roots, errs
+}
+
+// ValidatorsRootAtSlot returns the root of the validator set at the given
+// slot, from the same version of the state that proofs are generated against.
+// If the state of the slot is no longer retained, the returned error wraps
+// ErrSlotOutsideHistory.
+func (b *Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorsRootAtSlot(slot math.Slot) (common.Root, error) {
+	st, _, err := b.StateFromSlotForProof(slot)
+	if err != nil {
+		return common.Root{}, errors.Join(
+			errors.Wrapf(ErrSlotOutsideHistory, "slot %d", slot), err,
+		)
+	}
+
+	validators, err := st.GetValidators()
+	if err != nil {
+		return common.Root{}, err
+	}
+	return validators.HashTreeRoot(), nil
+}
+
 // GetStateFork returns the fork of the state at the given stateID.
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, ForkT, _, _, _, _, _, _, _,
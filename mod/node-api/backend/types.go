@@ -42,6 +42,11 @@ type AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT any] interface {
 	// Persist makes sure that the sidecar remains accessible for data
 	// availability checks throughout the beacon node's operation.
 	Persist(math.Slot, BlobSidecarsT) error
+	// GetBlobSidecarsRange returns the blob sidecars stored for every slot in
+	// the range [start, end), skipping slots without sidecars.
+	GetBlobSidecarsRange(
+		start, end math.Slot,
+	) (map[math.Slot]BlobSidecarsT, error)
 }
 
 // BeaconBlockHeader is the interface for a beacon block header.
@@ -80,6 +85,8 @@ type BeaconState[
 type BlockStore[BeaconBlockT any] interface {
 	// GetSlotByBlockRoot retrieves the slot by a given block root.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// GetSlotsByBlockRoots retrieves the slots by the given block roots.
+	GetSlotsByBlockRoots(roots []common.Root) (map[common.Root]math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given state root.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	// GetParentSlotByTimestamp retrieves the parent slot by a given timestamp.
@@ -124,6 +131,19 @@ type Validator[WithdrawalCredentialsT WithdrawalCredentials] interface {
 	// GetWithdrawalCredentials returns the withdrawal credentials of the
 	// validator.
 	GetWithdrawalCredentials() WithdrawalCredentialsT
+	// GetEffectiveBalance returns the effective balance of the validator.
+	GetEffectiveBalance() math.Gwei
+	// GetActivationEligibilityEpoch returns the epoch when the validator
+	// became eligible for activation.
+	GetActivationEligibilityEpoch() math.Epoch
+	// GetActivationEpoch returns the epoch when the validator activated.
+	GetActivationEpoch() math.Epoch
+	// GetExitEpoch returns the epoch when the validator exited.
+	GetExitEpoch() math.Epoch
+	// GetWithdrawableEpoch returns the epoch when the validator can withdraw.
+	GetWithdrawableEpoch() math.Epoch
+	// IsSlashed returns whether the validator has been slashed.
+	IsSlashed() bool
 	// IsFullyWithdrawable checks if the validator is fully withdrawable given a
 	// certain Gwei amount and epoch.
 	IsFullyWithdrawable(amount math.Gwei, epoch math.Epoch) bool
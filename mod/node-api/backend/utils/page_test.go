@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		name      string
+		offset    uint64
+		limit     uint64
+		total     uint64
+		wantStart uint64
+		wantEnd   uint64
+	}{
+		{
+			name:      "First page",
+			offset:    0,
+			limit:     2,
+			total:     5,
+			wantStart: 0,
+			wantEnd:   2,
+		},
+		{
+			name:      "Last partial page",
+			offset:    4,
+			limit:     2,
+			total:     5,
+			wantStart: 4,
+			wantEnd:   5,
+		},
+		{
+			name:      "Offset past total",
+			offset:    7,
+			limit:     2,
+			total:     5,
+			wantStart: 5,
+			wantEnd:   5,
+		},
+		{
+			name:      "Zero limit",
+			offset:    1,
+			limit:     0,
+			total:     5,
+			wantStart: 1,
+			wantEnd:   5,
+		},
+		{
+			name:      "Limit overflowing total",
+			offset:    1,
+			limit:     ^uint64(0),
+			total:     5,
+			wantStart: 1,
+			wantEnd:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := utils.PageBounds(tt.offset, tt.limit, tt.total)
+			require.Equal(t, tt.wantStart, start)
+			require.Equal(t, tt.wantEnd, end)
+		})
+	}
+}
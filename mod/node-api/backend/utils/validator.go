@@ -22,13 +22,31 @@ package utils
 
 import (
 	"strconv"
+	"strings"
 
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
+// Validator statuses as defined by the beacon node API.
+const (
+	StatusPendingInitialized = "pending_initialized"
+	StatusPendingQueued      = "pending_queued"
+	StatusActiveOngoing      = "active_ongoing"
+	StatusActiveExiting      = "active_exiting"
+	StatusActiveSlashed      = "active_slashed"
+	StatusExitedUnslashed    = "exited_unslashed"
+	StatusExitedSlashed      = "exited_slashed"
+	StatusWithdrawalPossible = "withdrawal_possible"
+	StatusWithdrawalDone     = "withdrawal_done"
+)
+
 // ValidatorIndexByID parses a validator index from a string.
-// The string can be either a validator index or a validator pubkey.
+// The string can be either a validator index or a validator pubkey. A string
+// that is neither yields an error wrapping types.ErrInvalidRequest.
 func ValidatorIndexByID[
 	BeaconStateT interface {
 		ValidatorIndexByPubkey(key crypto.BLSPubkey) (math.U64, error)
@@ -40,7 +58,61 @@ func ValidatorIndexByID[
 	}
 	var key crypto.BLSPubkey
 	if err = key.UnmarshalText([]byte(keyOrIndex)); err != nil {
-		return math.U64(0), err
+		return math.U64(0), errors.Join(types.ErrInvalidRequest, err)
 	}
 	return st.ValidatorIndexByPubkey(key)
 }
+
+// ValidatorStatus returns the status of the validator at the given epoch, as
+// defined by the beacon node API.
+func ValidatorStatus[
+	ValidatorT interface {
+		GetEffectiveBalance() math.Gwei
+		GetActivationEligibilityEpoch() math.Epoch
+		GetActivationEpoch() math.Epoch
+		GetExitEpoch() math.Epoch
+		GetWithdrawableEpoch() math.Epoch
+		IsSlashed() bool
+	},
+](validator ValidatorT, epoch math.Epoch) string {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	switch {
+	case epoch < validator.GetActivationEpoch():
+		if validator.GetActivationEligibilityEpoch() == farFutureEpoch {
+			return StatusPendingInitialized
+		}
+		return StatusPendingQueued
+	case epoch < validator.GetExitEpoch():
+		if validator.GetExitEpoch() == farFutureEpoch {
+			return StatusActiveOngoing
+		}
+		if validator.IsSlashed() {
+			return StatusActiveSlashed
+		}
+		return StatusActiveExiting
+	case epoch < validator.GetWithdrawableEpoch():
+		if validator.IsSlashed() {
+			return StatusExitedSlashed
+		}
+		return StatusExitedUnslashed
+	case validator.GetEffectiveBalance() > 0:
+		return StatusWithdrawalPossible
+	default:
+		return StatusWithdrawalDone
+	}
+}
+
+// StatusMatches returns true if the status matches any of the given statuses.
+// A status also matches its general status, e.g. "active" matches
+// "active_ongoing". An empty list of statuses matches every status.
+func StatusMatches(status string, statuses []string) bool {
+	if len(statuses) == 0 {
+		return true
+	}
+	for _, s := range statuses {
+		if status == s || strings.HasPrefix(status, s+"_") {
+			return true
+		}
+	}
+	return false
+}
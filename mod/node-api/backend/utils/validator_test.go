@@ -0,0 +1,248 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
+	apitypes "github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constants"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorStatus(t *testing.T) {
+	farFutureEpoch := math.Epoch(constants.FarFutureEpoch)
+	tests := []struct {
+		name      string
+		validator *types.Validator
+		epoch     math.Epoch
+		want      string
+	}{
+		{
+			name: "Pending initialized",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: farFutureEpoch,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			epoch: 10,
+			want:  utils.StatusPendingInitialized,
+		},
+		{
+			name: "Pending queued",
+			validator: &types.Validator{
+				ActivationEligibilityEpoch: 5,
+				ActivationEpoch:            farFutureEpoch,
+				ExitEpoch:                  farFutureEpoch,
+				WithdrawableEpoch:          farFutureEpoch,
+			},
+			epoch: 10,
+			want:  utils.StatusPendingQueued,
+		},
+		{
+			name: "Active ongoing",
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         farFutureEpoch,
+				WithdrawableEpoch: farFutureEpoch,
+			},
+			epoch: 10,
+			want:  utils.StatusActiveOngoing,
+		},
+		{
+			name: "Active exiting",
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         20,
+				WithdrawableEpoch: 30,
+			},
+			epoch: 10,
+			want:  utils.StatusActiveExiting,
+		},
+		{
+			name: "Active slashed",
+			validator: &types.Validator{
+				Slashed:           true,
+				ActivationEpoch:   5,
+				ExitEpoch:         20,
+				WithdrawableEpoch: 30,
+			},
+			epoch: 10,
+			want:  utils.StatusActiveSlashed,
+		},
+		{
+			name: "Exited unslashed",
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 30,
+			},
+			epoch: 10,
+			want:  utils.StatusExitedUnslashed,
+		},
+		{
+			name: "Exited slashed",
+			validator: &types.Validator{
+				Slashed:           true,
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 30,
+			},
+			epoch: 10,
+			want:  utils.StatusExitedSlashed,
+		},
+		{
+			name: "Withdrawal possible",
+			validator: &types.Validator{
+				EffectiveBalance:  32e9,
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 10,
+			},
+			epoch: 10,
+			want:  utils.StatusWithdrawalPossible,
+		},
+		{
+			name: "Withdrawal done",
+			validator: &types.Validator{
+				ActivationEpoch:   5,
+				ExitEpoch:         10,
+				WithdrawableEpoch: 10,
+			},
+			epoch: 10,
+			want:  utils.StatusWithdrawalDone,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want, utils.ValidatorStatus(tt.validator, tt.epoch),
+			)
+		})
+	}
+}
+
+func TestStatusMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		statuses []string
+		want     bool
+	}{
+		{
+			name:     "No filter",
+			status:   utils.StatusActiveOngoing,
+			statuses: nil,
+			want:     true,
+		},
+		{
+			name:     "Exact match",
+			status:   utils.StatusExitedSlashed,
+			statuses: []string{utils.StatusActiveOngoing, "exited_slashed"},
+			want:     true,
+		},
+		{
+			name:     "General status match",
+			status:   utils.StatusActiveExiting,
+			statuses: []string{"active"},
+			want:     true,
+		},
+		{
+			name:     "No match",
+			status:   utils.StatusPendingQueued,
+			statuses: []string{"active", utils.StatusExitedSlashed},
+			want:     false,
+		},
+		{
+			name:     "Partial word does not match",
+			status:   utils.StatusActiveOngoing,
+			statuses: []string{"act"},
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(
+				t, tt.want, utils.StatusMatches(tt.status, tt.statuses),
+			)
+		})
+	}
+}
+
+type pubkeyIndexer map[crypto.BLSPubkey]math.U64
+
+func (p pubkeyIndexer) ValidatorIndexByPubkey(
+	key crypto.BLSPubkey,
+) (math.U64, error) {
+	index, ok := p[key]
+	if !ok {
+		return 0, errors.New("pubkey not found")
+	}
+	return index, nil
+}
+
+func TestValidatorIndexByID(t *testing.T) {
+	key := crypto.BLSPubkey{0x01}
+	st := pubkeyIndexer{key: 7}
+	tests := []struct {
+		name       string
+		id         string
+		want       math.U64
+		wantErr    bool
+		wantBadReq bool
+	}{
+		{name: "Index", id: "3", want: 3},
+		{name: "Known pubkey", id: key.String(), want: 7},
+		{
+			name:    "Unknown pubkey",
+			id:      crypto.BLSPubkey{0x02}.String(),
+			wantErr: true,
+		},
+		{
+			name:       "Malformed ID",
+			id:         "not-an-id",
+			wantErr:    true,
+			wantBadReq: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := utils.ValidatorIndexByID(st, tt.id)
+			if !tt.wantErr {
+				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
+				return
+			}
+			require.Error(t, err)
+			require.Equal(
+				t, tt.wantBadReq, errors.Is(err, apitypes.ErrInvalidRequest),
+			)
+		})
+	}
+}
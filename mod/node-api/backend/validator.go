@@ -21,8 +21,11 @@
 package backend
 
 import (
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/node-api/backend/utils"
 	beacontypes "github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/node-api/handlers/types"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
 
@@ -34,17 +37,19 @@ func (b Backend[
 	// TODO: to adhere to the spec, this shouldn't error if the error
 	// is not found, but i can't think of a way to do that without coupling
 	// db impl to the api impl.
-	st, _, err := b.stateFromSlot(slot)
+	st, slot, err := b.stateFromSlot(slot)
 	if err != nil {
 		return nil, err
 	}
-	index, err := utils.ValidatorIndexByID(st, id)
+	index, err := validatorIndexByID(st, id)
 	if err != nil {
 		return nil, err
 	}
 	validator, err := st.ValidatorByIndex(index)
 	if err != nil {
-		return nil, err
+		return nil, errors.Join(
+			errors.Wrapf(ErrValidatorNotFound, "id %s", id), err,
+		)
 	}
 	balance, err := st.GetBalance(index)
 	if err != nil {
@@ -55,7 +60,9 @@ func (b Backend[
 			Index:   index.Unwrap(),
 			Balance: balance.Unwrap(),
 		},
-		Status:    "active_ongoing", // TODO: fix
+		Status: utils.ValidatorStatus(
+			validator, b.cs.SlotToEpoch(slot),
+		),
 		Validator: validator,
 	}, nil
 }
@@ -80,6 +87,49 @@ func (b Backend[
 	return validatorsData, nil
 }
 
+// ValidatorsByStatus returns all validators at the given slot whose status
+// matches one of the given statuses.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, ValidatorT, _, _, _,
+]) ValidatorsByStatus(
+	slot math.Slot, statuses []string,
+) ([]*beacontypes.ValidatorData[ValidatorT], error) {
+	st, slot, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := st.GetValidators()
+	if err != nil {
+		return nil, err
+	}
+
+	epoch := b.cs.SlotToEpoch(slot)
+	validatorsData := make([]*beacontypes.ValidatorData[ValidatorT], 0)
+	for i, validator := range validators {
+		status := utils.ValidatorStatus(validator, epoch)
+		if !utils.StatusMatches(status, statuses) {
+			continue
+		}
+		//#nosec:G701 // not an issue in practice.
+		index := math.ValidatorIndex(i)
+		var balance math.Gwei
+		if balance, err = st.GetBalance(index); err != nil {
+			return nil, err
+		}
+		validatorsData = append(
+			validatorsData, &beacontypes.ValidatorData[ValidatorT]{
+				ValidatorBalanceData: beacontypes.ValidatorBalanceData{
+					Index:   index.Unwrap(),
+					Balance: balance.Unwrap(),
+				},
+				Status:    status,
+				Validator: validator,
+			},
+		)
+	}
+	return validatorsData, nil
+}
+
 func (b Backend[
 	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ValidatorBalancesByIDs(
@@ -92,7 +142,7 @@ func (b Backend[
 	}
 	balances := make([]*beacontypes.ValidatorBalanceData, 0)
 	for _, id := range ids {
-		index, err = utils.ValidatorIndexByID(st, id)
+		index, err = validatorIndexByID(st, id)
 		if err != nil {
 			return nil, err
 		}
@@ -109,3 +159,59 @@ func (b Backend[
 	}
 	return balances, nil
 }
+
+// ValidatorBalancesByIDsPaged returns the page of balances for the given IDs
+// starting at offset and holding at most limit entries, along with the total
+// number of entries. If no IDs are given, it pages over all validators ordered
+// by index.
+func (b Backend[
+	_, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ValidatorBalancesByIDsPaged(
+	slot math.Slot, ids []string, offset, limit uint64,
+) ([]*beacontypes.ValidatorBalanceData, uint64, error) {
+	if len(ids) > 0 {
+		total := uint64(len(ids))
+		start, end := utils.PageBounds(offset, limit, total)
+		balances, err := b.ValidatorBalancesByIDs(slot, ids[start:end])
+		return balances, total, err
+	}
+
+	st, _, err := b.stateFromSlot(slot)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := st.GetTotalValidators()
+	if err != nil {
+		return nil, 0, err
+	}
+	start, end := utils.PageBounds(offset, limit, total)
+	balances := make([]*beacontypes.ValidatorBalanceData, 0, end-start)
+	for i := start; i < end; i++ {
+		index := math.ValidatorIndex(i)
+		var balance math.Gwei
+		if balance, err = st.GetBalance(index); err != nil {
+			return nil, 0, err
+		}
+		balances = append(balances, &beacontypes.ValidatorBalanceData{
+			Index:   index.Unwrap(),
+			Balance: balance.Unwrap(),
+		})
+	}
+	return balances, total, nil
+}
+
+// validatorIndexByID resolves the validator index of the given ID in the state,
+// wrapping ErrValidatorNotFound if the ID is well-formed but unknown.
+func validatorIndexByID[
+	BeaconStateT interface {
+		ValidatorIndexByPubkey(key crypto.BLSPubkey) (math.U64, error)
+	},
+](st BeaconStateT, id string) (math.U64, error) {
+	index, err := utils.ValidatorIndexByID(st, id)
+	if err != nil && !errors.Is(err, types.ErrInvalidRequest) {
+		return index, errors.Join(
+			errors.Wrapf(ErrValidatorNotFound, "id %s", id), err,
+		)
+	}
+	return index, err
+}
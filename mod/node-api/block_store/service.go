@@ -43,6 +43,8 @@ type Service[
 	store BlockStoreT
 	// subFinalizedBlkEvents is a channel holding BeaconBlockFinalized
 	subFinalizedBlkEvents chan async.Event[BeaconBlockT]
+	// telemetrySink is the sink for the retained window metrics.
+	telemetrySink TelemetrySink
 }
 
 // NewService creates a new block service.
@@ -54,6 +56,7 @@ func NewService[
 	logger log.Logger,
 	dispatcher asynctypes.EventDispatcher,
 	store BlockStoreT,
+	telemetrySink TelemetrySink,
 ) *Service[BeaconBlockT, BlockStoreT] {
 	return &Service[BeaconBlockT, BlockStoreT]{
 		config:                config,
@@ -61,6 +64,7 @@ func NewService[
 		dispatcher:            dispatcher,
 		store:                 store,
 		subFinalizedBlkEvents: make(chan async.Event[BeaconBlockT]),
+		telemetrySink:         telemetrySink,
 	}
 }
 
@@ -103,7 +107,8 @@ func (s *Service[_, _]) eventLoop(ctx context.Context) {
 }
 
 // onFinalizeBlock is triggered when a finalized block event is received.
-// It stores the block in the KVStore.
+// It stores the block in the KVStore, which evicts the blocks that fall out
+// of the availability window, and reports the retained window.
 func (s *Service[BeaconBlockT, _]) onFinalizeBlock(
 	event async.Event[BeaconBlockT],
 ) {
@@ -112,5 +117,34 @@ func (s *Service[BeaconBlockT, _]) onFinalizeBlock(
 		s.logger.Error(
 			"failed to store block", "slot", slot, "error", err,
 		)
+		return
 	}
+	s.reportRetainedWindow()
+}
+
+// reportRetainedWindow logs and emits the [oldest, newest] slot window held
+// by the store, so operators can confirm the availability window in effect.
+func (s *Service[_, _]) reportRetainedWindow() {
+	_, oldest, err := s.store.GetOldest()
+	if err != nil {
+		s.logger.Error("failed to get oldest retained block", "error", err)
+		return
+	}
+	_, newest, err := s.store.GetLatest()
+	if err != nil {
+		s.logger.Error("failed to get newest retained block", "error", err)
+		return
+	}
+
+	s.logger.Debug(
+		"block store retained window",
+		"oldest_slot", oldest,
+		"newest_slot", newest,
+	)
+	s.telemetrySink.SetGauge(
+		"beacon_kit.block_store.oldest_retained_slot", float64(oldest),
+	)
+	s.telemetrySink.SetGauge(
+		"beacon_kit.block_store.newest_slot", float64(newest),
+	)
 }
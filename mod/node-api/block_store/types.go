@@ -37,6 +37,10 @@ type BeaconBlock interface {
 type BlockStore[BeaconBlockT BeaconBlock] interface {
 	// Set sets a block at a given index.
 	Set(blk BeaconBlockT) error
+	// GetOldest returns the retained block with the lowest slot.
+	GetOldest() (BeaconBlockT, math.Slot, error)
+	// GetLatest returns the retained block with the highest slot.
+	GetLatest() (BeaconBlockT, math.Slot, error)
 }
 
 // Event is an interface for block events.
@@ -49,6 +53,13 @@ type Event[BeaconBlockT BeaconBlock] interface {
 	Data() BeaconBlockT
 }
 
+// TelemetrySink is an interface for sending metrics to a telemetry backend.
+type TelemetrySink interface {
+	// SetGauge sets a gauge metric to the specified value, identified by the
+	// provided keys.
+	SetGauge(key string, value float64, args ...string)
+}
+
 // EventFeed is a generic interface for sending events.
 type EventFeed[EventT any] interface {
 	// Subscribe returns a channel that will receive events.
@@ -36,6 +36,9 @@ type Backend[BlockHeaderT, ForkT, ValidatorT any] interface {
 	HistoricalBackend[ForkT]
 	// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 	GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+	// GetSlotsByBlockRoots retrieves the slots by the given roots from the
+	// store.
+	GetSlotsByBlockRoots(roots []common.Root) (map[common.Root]math.Slot, error)
 	// GetSlotByStateRoot retrieves the slot by a given root from the store.
 	GetSlotByStateRoot(root common.Root) (math.Slot, error)
 }
@@ -46,6 +49,7 @@ type GenesisBackend interface {
 
 type HistoricalBackend[ForkT any] interface {
 	StateRootAtSlot(slot math.Slot) (common.Root, error)
+	StateRootsAtSlots(slots []math.Slot) ([]common.Root, error)
 	StateForkAtSlot(slot math.Slot) (ForkT, error)
 }
 
@@ -73,8 +77,17 @@ type ValidatorBackend[ValidatorT any] interface {
 		ids []string,
 		statuses []string,
 	) ([]*types.ValidatorData[ValidatorT], error)
+	ValidatorsByStatus(
+		slot math.Slot,
+		statuses []string,
+	) ([]*types.ValidatorData[ValidatorT], error)
 	ValidatorBalancesByIDs(
 		slot math.Slot,
 		ids []string,
 	) ([]*types.ValidatorBalanceData, error)
+	ValidatorBalancesByIDsPaged(
+		slot math.Slot,
+		ids []string,
+		offset, limit uint64,
+	) ([]*types.ValidatorBalanceData, uint64, error)
 }
@@ -32,13 +32,17 @@ type Config struct {
 	Address string `mapstructure:"address"`
 	// Logging is the flag to enable API logging.
 	Logging bool `mapstructure:"logging"`
+	// QueryContextCacheSize is the number of query contexts cached by the
+	// backend. A size of 0 disables the cache.
+	QueryContextCacheSize int `mapstructure:"query-context-cache-size"`
 }
 
 // DefaultConfig returns the default configuration for the node API server.
 func DefaultConfig() Config {
 	return Config{
-		Enabled: false,
-		Address: defaultAddress,
-		Logging: false,
+		Enabled:               false,
+		Address:               defaultAddress,
+		Logging:               false,
+		QueryContextCacheSize: 0,
 	}
 }
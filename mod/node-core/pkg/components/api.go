@@ -29,6 +29,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/server"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/block"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,7 +39,7 @@ func ProvideNodeAPIEngine() *echo.Engine {
 }
 
 type NodeAPIBackendInput[
-	BeaconBlockT any,
+	BeaconBlockT block.BeaconBlock,
 	BeaconStateT any,
 	DepositT any,
 	ExecutionPayloadHeaderT ExecutionPayloadHeader[ExecutionPayloadHeaderT],
@@ -46,8 +47,10 @@ type NodeAPIBackendInput[
 ] struct {
 	depinject.In
 
-	ChainSpec      common.ChainSpec
-	StateProcessor StateProcessor[
+	ArchivalBlockStore *block.ReadOnlyStore[BeaconBlockT] `optional:"true"`
+	ChainSpec          common.ChainSpec
+	Config             *config.Config
+	StateProcessor     StateProcessor[
 		BeaconBlockT, BeaconStateT, *Context,
 		DepositT, ExecutionPayloadHeaderT,
 	]
@@ -56,7 +59,9 @@ type NodeAPIBackendInput[
 
 func ProvideNodeAPIBackend[
 	AvailabilityStoreT AvailabilityStore[BeaconBlockBodyT, BlobSidecarsT],
-	BeaconBlockT any,
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
 	BeaconBlockBodyT any,
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
 	BeaconBlockStoreT BlockStore[BeaconBlockT],
@@ -83,14 +88,14 @@ func ProvideNodeAPIBackend[
 		BeaconBlockT, BeaconStateT, DepositT, ExecutionPayloadHeaderT,
 		StorageBackendT,
 	],
-) *backend.Backend[
+) (*backend.Backend[
 	AvailabilityStoreT, BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
 	BeaconStateT, BeaconStateMarshallableT, BlobSidecarsT, BeaconBlockStoreT,
 	sdk.Context, DepositT, DepositStoreT, *Eth1Data, ExecutionPayloadHeaderT,
 	*Fork, NodeT, KVStoreT, StorageBackendT, *Validator, Validators,
 	WithdrawalT, WithdrawalCredentials,
-] {
-	return backend.New[
+], error) {
+	b, err := backend.New[
 		AvailabilityStoreT,
 		BeaconBlockT,
 		BeaconBlockBodyT,
@@ -116,7 +121,15 @@ func ProvideNodeAPIBackend[
 		in.StorageBackend,
 		in.ChainSpec,
 		in.StateProcessor,
+		in.Config.NodeAPI.QueryContextCacheSize,
 	)
+	if err != nil {
+		return nil, err
+	}
+	if in.ArchivalBlockStore != nil {
+		b.AttachReadOnlyBlockStore(in.ArchivalBlockStore)
+	}
+	return b, nil
 }
 
 type NodeAPIServerInput[
@@ -88,7 +88,8 @@ type AvailabilityPrunerInput[
 }
 
 // ProvideAvailabilityPruner provides a availability pruner for the depinject
-// framework.
+// framework. On every finalized block, it prunes the sidecars of the slots
+// below the window of MinEpochsForBlobsSidecarsRequest epochs.
 func ProvideAvailabilityPruner[
 	AvailabilityStoreT AvailabilityStore[
 		BeaconBlockBodyT, BlobSidecarsT,
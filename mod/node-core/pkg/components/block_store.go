@@ -62,3 +62,24 @@ func ProvideBlockStore[
 		in.Config.BlockStoreService.AvailabilityWindow,
 	), nil
 }
+
+// ArchivalBlockStoreInput is the input for the dep inject framework.
+type ArchivalBlockStoreInput[BeaconBlockT block.BeaconBlock] struct {
+	depinject.In
+
+	BlockStore *block.KVStore[BeaconBlockT]
+}
+
+// ProvideArchivalBlockStore provides a read-only view of the block store for
+// the node API, so that its reads stay separate from the consensus writes.
+func ProvideArchivalBlockStore[
+	BeaconBlockT BeaconBlock[
+		BeaconBlockT, BeaconBlockBodyT, BeaconBlockHeaderT,
+	],
+	BeaconBlockBodyT any,
+	BeaconBlockHeaderT any,
+](
+	in ArchivalBlockStoreInput[BeaconBlockT],
+) *block.ReadOnlyStore[BeaconBlockT] {
+	return block.NewReadOnlyStore(in.BlockStore)
+}
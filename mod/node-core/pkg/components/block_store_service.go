@@ -25,6 +25,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/log"
 	blockstore "github.com/berachain/beacon-kit/mod/node-api/block_store"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 )
 
 // BlockServiceInput is the input for the block service.
@@ -35,10 +36,11 @@ type BlockServiceInput[
 ] struct {
 	depinject.In
 
-	BlockStore BeaconBlockStoreT
-	Config     *config.Config
-	Dispatcher Dispatcher
-	Logger     LoggerT
+	BlockStore    BeaconBlockStoreT
+	Config        *config.Config
+	Dispatcher    Dispatcher
+	Logger        LoggerT
+	TelemetrySink *metrics.TelemetrySink
 }
 
 // ProvideBlockStoreService provides the block service.
@@ -60,5 +62,6 @@ func ProvideBlockStoreService[
 		in.Logger,
 		in.Dispatcher,
 		in.BlockStore,
+		in.TelemetrySink,
 	)
 }
@@ -138,5 +138,7 @@ func ProvideChainService[
 		in.TelemetrySink,
 		// If optimistic is enabled, we want to skip post finalization FCUs.
 		in.Cfg.Validator.EnableOptimisticPayloadBuilds,
+		in.Cfg.Engine.FCURetries,
+		in.Cfg.Engine.FCURetryBaseDelay,
 	)
 }
@@ -38,6 +38,7 @@ func ProvideCometBFTService[
 	logger LoggerT,
 	storeKey *storetypes.KVStoreKey,
 	abciMiddleware cometbft.MiddlewareI,
+	genesisExporter cometbft.GenesisExporter,
 	db dbm.DB,
 	cmtCfg *cmtcfg.Config,
 	appOpts config.AppOptions,
@@ -50,6 +51,9 @@ func ProvideCometBFTService[
 		abciMiddleware,
 		cmtCfg,
 		chainSpec,
-		builder.DefaultServiceOptions[LoggerT](appOpts)...,
+		append(
+			builder.DefaultServiceOptions[LoggerT](appOpts),
+			cometbft.SetGenesisExporter[LoggerT](genesisExporter),
+		)...,
 	)
 }
@@ -49,9 +49,7 @@ type BeaconDepositContractInput[
 // ProvideBeaconDepositContract provides a beacon deposit contract through the
 // dep inject framework.
 func ProvideBeaconDepositContract[
-	DepositT Deposit[
-		DepositT, *ForkData, WithdrawalCredentials,
-	],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
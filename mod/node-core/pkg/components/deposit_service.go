@@ -68,9 +68,7 @@ func ProvideDepositService[
 		*Eth1Data, ExecutionPayloadT, *SlashingInfo,
 	],
 	BeaconBlockHeaderT any,
-	DepositT Deposit[
-		DepositT, *ForkData, WithdrawalCredentials,
-	],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 	DepositContractT deposit.Contract[DepositT],
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[
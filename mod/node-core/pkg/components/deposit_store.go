@@ -21,8 +21,12 @@
 package components
 
 import (
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
 	"cosmossdk.io/depinject"
 	storev2 "cosmossdk.io/store/v2/db"
+	beaconflags "github.com/berachain/beacon-kit/mod/cli/pkg/flags"
 	"github.com/berachain/beacon-kit/mod/config"
 	"github.com/berachain/beacon-kit/mod/execution/pkg/deposit"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -45,15 +49,17 @@ type DepositStoreInput struct {
 // ProvideDepositStore is a function that provides the module to the
 // application.
 func ProvideDepositStore[
-	DepositT Deposit[
-		DepositT, *ForkData, WithdrawalCredentials,
-	],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 ](
 	in DepositStoreInput,
 ) (*depositstore.KVStore[DepositT], error) {
 	name := "deposits"
 	dir := cast.ToString(in.AppOpts.Get(flags.FlagHome)) + "/data"
-	kvp, err := storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	kvp, err := newDepositDB(
+		cast.ToString(in.AppOpts.Get(beaconflags.DepositStoreDBBackend)),
+		name,
+		dir,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +67,61 @@ func ProvideDepositStore[
 	return depositstore.NewStore[DepositT](storage.NewKVStoreProvider(kvp)), nil
 }
 
+// defaultDepositCacheSize is the number of deposit ranges cached by the
+// caching deposit store if no cache size is configured.
+const defaultDepositCacheSize = 256
+
+// CachingDepositStoreInput is the input for the dep inject framework.
+type CachingDepositStoreInput[
+	DepositT depositstore.Deposit[DepositT],
+] struct {
+	depinject.In
+	AppOpts      config.AppOptions
+	DepositStore *depositstore.KVStore[DepositT]
+}
+
+// ProvideCachingDepositStore provides a deposit store with a read-through
+// cache in front of the store provided by ProvideDepositStore. Applications
+// opt into it by using it as their deposit store.
+func ProvideCachingDepositStore[
+	DepositT Deposit[DepositT, WithdrawalCredentials],
+](
+	in CachingDepositStoreInput[DepositT],
+) (*depositstore.CachingDepositStore[DepositT], error) {
+	cacheSize := cast.ToInt(in.AppOpts.Get(beaconflags.DepositStoreCacheSize))
+	if cacheSize <= 0 {
+		cacheSize = defaultDepositCacheSize
+	}
+	return depositstore.NewCachingStore[DepositT](in.DepositStore, cacheSize)
+}
+
+// dbTypeMemDB selects an in-memory deposit store, which is not persisted.
+const dbTypeMemDB storev2.DBType = "memdb"
+
+// newDepositDB opens the deposit database with the given backend, defaulting
+// to PebbleDB when no backend is configured.
+func newDepositDB(
+	backend, name, dir string,
+) (corestore.KVStoreWithBatch, error) {
+	switch dbType := storev2.DBType(backend); dbType {
+	case "", storev2.DBTypePebbleDB:
+		return storev2.NewDB(storev2.DBTypePebbleDB, name, dir, nil)
+	case storev2.DBTypeGoLevelDB:
+		return storev2.NewDB(dbType, name, dir, nil)
+	case dbTypeMemDB:
+		return storev2.NewMemDB(), nil
+	default:
+		return nil, fmt.Errorf(
+			"unsupported deposit store db backend %q, expected one of %s, %s "+
+				"or %s",
+			backend,
+			storev2.DBTypePebbleDB,
+			storev2.DBTypeGoLevelDB,
+			dbTypeMemDB,
+		)
+	}
+}
+
 // DepositPrunerInput is the input for the deposit pruner.
 type DepositPrunerInput[
 	BeaconBlockT any,
@@ -83,9 +144,7 @@ func ProvideDepositPruner[
 		GetDeposits() []DepositT
 	},
 	BeaconBlockHeaderT any,
-	DepositT Deposit[
-		DepositT, *ForkData, WithdrawalCredentials,
-	],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 	DepositStoreT DepositStore[DepositT],
 	LoggerT log.AdvancedLogger[LoggerT],
 ](
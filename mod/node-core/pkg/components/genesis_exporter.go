@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package components
+
+import (
+	"github.com/berachain/beacon-kit/mod/beacon/blockchain"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// ProvideGenesisExporter is a depinject provider for the genesis exporter.
+func ProvideGenesisExporter[
+	AvailabilityStoreT any,
+	BeaconStateT blockchain.ExportableBeaconState[
+		BeaconStateMarshallableT, ExecutionPayloadHeaderT, Validators,
+	],
+	BeaconStateMarshallableT constraints.SSZMarshaler,
+	DepositT blockchain.Deposit[DepositT, WithdrawalCredentials],
+	ExecutionPayloadHeaderT any,
+	GenesisT blockchain.GenesisFactory[
+		GenesisT, DepositT, ExecutionPayloadHeaderT,
+	],
+	StorageBackendT blockchain.StorageBackend[
+		AvailabilityStoreT, BeaconStateT,
+	],
+](
+	storageBackend StorageBackendT,
+	chainSpec common.ChainSpec,
+) *blockchain.GenesisExporter[
+	AvailabilityStoreT, BeaconStateT, BeaconStateMarshallableT, DepositT,
+	ExecutionPayloadHeaderT, GenesisT, *Validator, Validators,
+	WithdrawalCredentials,
+] {
+	return blockchain.NewGenesisExporter[
+		AvailabilityStoreT, BeaconStateT, BeaconStateMarshallableT, DepositT,
+		ExecutionPayloadHeaderT, GenesisT, *Validator, Validators,
+		WithdrawalCredentials,
+	](storageBackend, chainSpec)
+}
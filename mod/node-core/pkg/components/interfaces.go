@@ -25,10 +25,12 @@ import (
 	"context"
 	"encoding/json"
 
+	dastore "github.com/berachain/beacon-kit/mod/da/pkg/store"
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers"
 	"github.com/berachain/beacon-kit/mod/node-api/handlers/beacon/types"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
@@ -66,6 +68,7 @@ type (
 			slot math.Slot,
 			timestamp uint64,
 			prevHeadRoot [32]byte,
+			overrides ...attributes.PayloadAttributesOverrides,
 		) (PayloadAttributesT, error)
 	}
 
@@ -75,9 +78,27 @@ type (
 		// IsDataAvailable ensures that all blobs referenced in the block are
 		// securely stored before it returns without an error.
 		IsDataAvailable(context.Context, math.Slot, BeaconBlockBodyT) bool
+		// MissingBlobIndices returns the indices of the KZG commitments in
+		// the block for which no sidecar is stored.
+		MissingBlobIndices(
+			context.Context, math.Slot, BeaconBlockBodyT,
+		) ([]uint64, error)
 		// Persist makes sure that the sidecar remains accessible for data
 		// availability checks throughout the beacon node's operation.
 		Persist(math.Slot, BlobSidecarsT) error
+		// PersistWithStats persists the sidecars like Persist and reports
+		// the number of sidecars and bytes written.
+		PersistWithStats(
+			math.Slot, BlobSidecarsT,
+		) (dastore.PersistStats, error)
+		// Prune removes the sidecars of the slots in the range [start, end).
+		// It is driven by the availability pruner on finalized blocks.
+		Prune(start, end uint64) error
+		// GetBlobSidecarsRange returns the blob sidecars stored for every
+		// slot in the range [start, end).
+		GetBlobSidecarsRange(
+			start, end math.Slot,
+		) (map[math.Slot]BlobSidecarsT, error)
 	}
 
 	// BeaconBlock represents a generic interface for a beacon block.
@@ -116,6 +137,12 @@ type (
 		// GetTimestamp returns the timestamp of the block from the execution
 		// payload.
 		GetTimestamp() math.U64
+		// GetExecutionNumber returns the block number of the block from the
+		// execution payload.
+		GetExecutionNumber() math.U64
+		// GetNumBlobKzgCommitments returns the number of blob KZG commitments
+		// in the body of the block.
+		GetNumBlobKzgCommitments() int
 	}
 
 	// BeaconBlockBody represents a generic interface for the body of a beacon
@@ -139,6 +166,8 @@ type (
 		GetExecutionPayload() ExecutionPayloadT
 		// GetDeposits returns the list of deposits.
 		GetDeposits() []DepositT
+		// GetEth1Data returns the Eth1 data of the beacon block body.
+		GetEth1Data() Eth1DataT
 		// GetBlobKzgCommitments returns the KZG commitments for the blobs.
 		GetBlobKzgCommitments() eip4844.KZGCommitments[common.ExecutionHash]
 		// SetRandaoReveal sets the Randao reveal of the beacon block body.
@@ -171,6 +200,8 @@ type (
 			stateRoot common.Root,
 			bodyRoot common.Root,
 		) T
+		// Equals returns true if the header is equal to the other.
+		Equals(other T) bool
 		GetSlot() math.Slot
 		GetProposerIndex() math.ValidatorIndex
 		GetParentBlockRoot() common.Root
@@ -230,6 +261,12 @@ type (
 		VerifySidecars(
 			sidecars BlobSidecarsT,
 		) error
+		// VerifySidecarsBatch verifies the blobs like VerifySidecars, with a
+		// single batched KZG proof verification, and identifies the invalid
+		// sidecar if the batch fails.
+		VerifySidecarsBatch(
+			sidecars BlobSidecarsT,
+		) error
 	}
 
 	BlobSidecar[BeaconBlockHeaderT any] interface {
@@ -255,6 +292,7 @@ type (
 		VerifyInclusionProofs(scs BlobSidecarsT, kzgOffset uint64) error
 		VerifyKZGProofs(scs BlobSidecarsT) error
 		VerifySidecars(sidecars BlobSidecarsT, kzgOffset uint64) error
+		VerifySidecarsBatch(sidecars BlobSidecarsT, kzgOffset uint64) error
 	}
 
 	// 	// BlockchainService defines the interface for interacting with the
@@ -291,13 +329,48 @@ type (
 	// BlockStore is the interface for block storage.
 	BlockStore[BeaconBlockT any] interface {
 		Set(blk BeaconBlockT) error
+		// Prune removes the blocks in the slot range [start, end).
+		Prune(start, end uint64) error
+		// PruneBefore removes the blocks whose execution payload timestamp
+		// is before the given timestamp.
+		PruneBefore(timestamp uint64) error
+		// GetRange retrieves the blocks in the slot range [start, end).
+		GetRange(start, end math.Slot) ([]BeaconBlockT, error)
+		// GetLatest retrieves the block with the highest slot, along with
+		// its slot.
+		GetLatest() (BeaconBlockT, math.Slot, error)
+		// GetOldest retrieves the block with the lowest slot, along with
+		// its slot.
+		GetOldest() (BeaconBlockT, math.Slot, error)
+		// GetByRoot retrieves the block by a given block root from the
+		// store.
+		GetByRoot(root common.Root) (BeaconBlockT, error)
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+		// GetSlotsByBlockRoots retrieves the slots by the given roots from the
+		// store.
+		GetSlotsByBlockRoots(
+			roots []common.Root,
+		) (map[common.Root]math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
+		// GetByExecutionNumber retrieves the block wrapping the execution
+		// payload of the given execution number from the store.
+		GetByExecutionNumber(executionNumber math.U64) (BeaconBlockT, error)
+		// GetSlotByExecutionNumber retrieves the slot by a given execution
+		// number from the store.
+		GetSlotByExecutionNumber(executionNumber math.U64) (math.Slot, error)
+		// GetSlotByExecutionNumberOrBelow retrieves the slot of the block
+		// with the highest execution number at or below the given one.
+		GetSlotByExecutionNumberOrBelow(
+			executionNumber math.U64,
+		) (math.Slot, error)
 		// GetParentSlotByTimestamp retrieves the parent slot by a given
 		// timestamp from the store.
 		GetParentSlotByTimestamp(timestamp math.U64) (math.Slot, error)
+		// Verify checks that the indexes of the store agree with the stored
+		// blocks.
+		Verify() error
 	}
 
 	ConsensusEngine interface {
@@ -307,6 +380,17 @@ type (
 		ProcessProposal(
 			ctx sdk.Context, req *v1.ProcessProposalRequest,
 		) (*v1.ProcessProposalResponse, error)
+		// ProcessProposalBatch processes proposals of the same height in
+		// order, returning their responses in the order of the requests.
+		ProcessProposalBatch(
+			ctx sdk.Context, reqs []*v1.ProcessProposalRequest,
+		) ([]*v1.ProcessProposalResponse, error)
+		// SimulateFinalizeBlock runs the finalize block path against a copy
+		// of the state, discarding all writes, and returns the resulting
+		// validator updates.
+		SimulateFinalizeBlock(
+			ctx sdk.Context, req *v1.FinalizeBlockRequest,
+		) (transition.ValidatorUpdates, error)
 	}
 
 	// 	// Context defines an interface for managing state transition context.
@@ -345,7 +429,6 @@ type (
 	// Deposit is the interface for a deposit.
 	Deposit[
 		T any,
-		ForkDataT any,
 		WithdrawalCredentialsT any,
 	] interface {
 		constraints.Empty[T]
@@ -366,10 +449,10 @@ type (
 		GetPubkey() crypto.BLSPubkey
 		// GetWithdrawalCredentials returns the withdrawal credentials.
 		GetWithdrawalCredentials() WithdrawalCredentialsT
-		// VerifySignature verifies the deposit and creates a validator.
+		// VerifySignature verifies the deposit signature over the given
+		// signing domain.
 		VerifySignature(
-			forkData ForkDataT,
-			domainType common.DomainType,
+			domain common.Domain,
 			signatureVerificationFn func(
 				pubkey crypto.BLSPubkey,
 				message []byte, signature crypto.BLSSignature,
@@ -383,10 +466,16 @@ type (
 			startIndex uint64,
 			numView uint64,
 		) ([]DepositT, error)
+		// IterateDeposits calls fn on the deposits starting from the given
+		// index until fn returns an error.
+		IterateDeposits(startIndex uint64, fn func(DepositT) error) error
 		// Prune prunes the deposit store of [start, end)
 		Prune(start, end uint64) error
 		// EnqueueDeposits adds a list of deposits to the deposit store.
 		EnqueueDeposits(deposits []DepositT) error
+		// GetDepositRootAtIndex returns the root of the deposit tree over
+		// the deposits [0, index).
+		GetDepositRootAtIndex(index uint64) (common.Root, error)
 	}
 
 	// 	Eth1Data[T any] interface {
@@ -523,6 +612,9 @@ type (
 	// 	ForkData[T any] interface {
 	// 		// New creates a new fork data object.
 	// 		New(common.Version, common.Root) T
+	// 		// ComputeDomain returns the signing domain for the given domain
+	// 		// type.
+	// 		ComputeDomain(domainType common.DomainType) common.Domain
 	// 		// ComputeRandaoSigningRoot returns the signing root for the fork data.
 	// 		ComputeRandaoSigningRoot(
 	// 			domainType common.DomainType,
@@ -539,12 +631,17 @@ type (
 		GetDeposits() []DepositT
 		// GetExecutionPayloadHeader returns the execution payload header.
 		GetExecutionPayloadHeader() ExecutionPayloadHeaderT
+		// Validate returns an error if the genesis data is malformed.
+		Validate() error
 	}
 
 	// IndexDB is the interface for the range DB.
 	IndexDB interface {
 		Has(index uint64, key []byte) (bool, error)
 		Set(index uint64, key []byte, value []byte) error
+		SetBatch(index uint64, kvs []struct{ Key, Value []byte }) error
+		GetRange(start uint64, end uint64) (map[uint64][][]byte, error)
+		HasAny(start uint64, end uint64) (bool, error)
 		Prune(start uint64, end uint64) error
 	}
 
@@ -555,6 +652,11 @@ type (
 	] interface {
 		// Enabled returns true if the local builder is enabled.
 		Enabled() bool
+		// CachedPayloadIDs returns the IDs of the in-flight payloads.
+		CachedPayloadIDs() []engineprimitives.PayloadID
+		// HasPayloadFor returns true if a payload build was already
+		// requested for the given slot and parent block root.
+		HasPayloadFor(slot math.Slot, parentBlockRoot common.Root) bool
 		// RequestPayloadAsync requests a new payload for the given slot.
 		RequestPayloadAsync(
 			ctx context.Context,
@@ -644,12 +746,25 @@ type (
 		ProcessSlots(
 			st BeaconStateT, slot math.Slot,
 		) (transition.ValidatorUpdates, error)
+		// ProcessSlotsWithRoots processes the slots like ProcessSlots, and
+		// returns the state root after each slot crossed.
+		ProcessSlotsWithRoots(
+			st BeaconStateT, slot math.Slot,
+		) ([]common.Root, transition.ValidatorUpdates, error)
 		// Transition performs the core state transition.
 		Transition(
 			ctx ContextT,
 			st BeaconStateT,
 			blk BeaconBlockT,
 		) (transition.ValidatorUpdates, error)
+		// TransitionCopy performs the state transition on a copy of the
+		// given state, which is left untouched even on error, and returns
+		// the resulting state.
+		TransitionCopy(
+			ctx ContextT,
+			st BeaconStateT,
+			blk BeaconBlockT,
+		) (BeaconStateT, transition.ValidatorUpdates, error)
 	}
 
 	SidecarFactory[BeaconBlockT any, BlobSidecarsT any] interface {
@@ -751,6 +866,8 @@ type (
 		GetValidatorIndex() math.ValidatorIndex
 		// GetAddress returns the address of the withdrawal.
 		GetAddress() common.ExecutionAddress
+		// Validate returns an error if the withdrawal is malformed.
+		Validate() error
 	}
 
 	Withdrawals[WithdrawalT any] interface {
@@ -875,6 +992,8 @@ type (
 		SetEth1Data(data Eth1DataT) error
 		// GetValidators retrieves all validators.
 		GetValidators() (ValidatorsT, error)
+		// GetValidatorsRoot retrieves the hash tree root of all validators.
+		GetValidatorsRoot() (common.Root, error)
 		// GetBalances retrieves all balances.
 		GetBalances() ([]uint64, error)
 		// GetNextWithdrawalIndex retrieves the next withdrawal index.
@@ -931,6 +1050,11 @@ type (
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
+		// CometBFTAddressByValidatorIndex retrieves the comet BFT address of
+		// the validator at the given index.
+		CometBFTAddressByValidatorIndex(
+			idx math.ValidatorIndex,
+		) ([]byte, error)
 		// GetValidatorsByEffectiveBalance retrieves validators by effective
 		// balance.
 		GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
@@ -956,7 +1080,11 @@ type (
 		GetBlockRootAtIndex(uint64) (common.Root, error)
 		GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 		GetTotalActiveBalances(uint64) (math.Gwei, error)
+		// GetValidatorChurnLimit returns the number of validators that can
+		// enter or exit the validator set at the given epoch.
+		GetValidatorChurnLimit(math.Epoch) (uint64, error)
 		GetValidators() (ValidatorsT, error)
+		GetValidatorsRoot() (common.Root, error)
 		GetSlashingAtIndex(uint64) (math.Gwei, error)
 		GetTotalSlashing() (math.Gwei, error)
 		GetNextWithdrawalIndex() (uint64, error)
@@ -966,6 +1094,9 @@ type (
 		ValidatorIndexByCometBFTAddress(
 			cometBFTAddress []byte,
 		) (math.ValidatorIndex, error)
+		CometBFTAddressByValidatorIndex(
+			idx math.ValidatorIndex,
+		) ([]byte, error)
 	}
 
 	// WriteOnlyBeaconState is the interface for a write-only beacon state.
@@ -985,6 +1116,8 @@ type (
 		SetLatestBlockHeader(BeaconBlockHeaderT) error
 		IncreaseBalance(math.ValidatorIndex, math.Gwei) error
 		DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+		IncreaseBalances(map[math.ValidatorIndex]math.Gwei) error
+		DecreaseBalances(map[math.ValidatorIndex]math.Gwei) error
 		UpdateSlashingAtIndex(uint64, math.Gwei) error
 		SetNextWithdrawalIndex(uint64) error
 		SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
@@ -1037,6 +1170,10 @@ type (
 		ValidatorByIndex(
 			math.ValidatorIndex,
 		) (ValidatorT, error)
+
+		GetActiveValidatorIndices(
+			math.Epoch,
+		) ([]math.ValidatorIndex, error)
 	}
 
 	// WriteOnlyEth1Data has write access to eth1 data.
@@ -1060,6 +1197,7 @@ type (
 	// ReadOnlyWithdrawals only has read access to withdrawal methods.
 	ReadOnlyWithdrawals[WithdrawalT any] interface {
 		ExpectedWithdrawals() ([]WithdrawalT, error)
+		ExpectedWithdrawalsAtSlot(slot math.Slot) ([]WithdrawalT, error)
 	}
 )
 
@@ -1088,6 +1226,7 @@ type (
 		ValidatorT any,
 	] interface {
 		AttachQueryBackend(node NodeT)
+		InvalidateQueryContextCache()
 		ChainSpec() common.ChainSpec
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
@@ -1113,6 +1252,11 @@ type (
 		HistoricalBackend[ForkT]
 		// GetSlotByBlockRoot retrieves the slot by a given root from the store.
 		GetSlotByBlockRoot(root common.Root) (math.Slot, error)
+		// GetSlotsByBlockRoots retrieves the slots by the given roots from the
+		// store.
+		GetSlotsByBlockRoots(
+			roots []common.Root,
+		) (map[common.Root]math.Slot, error)
 		// GetSlotByStateRoot retrieves the slot by a given root from the store.
 		GetSlotByStateRoot(root common.Root) (math.Slot, error)
 	}
@@ -1132,6 +1276,7 @@ type (
 
 	HistoricalBackend[ForkT any] interface {
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
+		StateRootsAtSlots(slots []math.Slot) ([]common.Root, error)
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
 	}
 
@@ -1149,6 +1294,7 @@ type (
 		StateRootAtSlot(slot math.Slot) (common.Root, error)
 		StateForkAtSlot(slot math.Slot) (ForkT, error)
 		StateFromSlotForProof(slot math.Slot) (BeaconStateT, math.Slot, error)
+		ValidatorsRootAtSlot(slot math.Slot) (common.Root, error)
 	}
 
 	ValidatorBackend[ValidatorT any] interface {
@@ -1160,9 +1306,18 @@ type (
 			ids []string,
 			statuses []string,
 		) ([]*types.ValidatorData[ValidatorT], error)
+		ValidatorsByStatus(
+			slot math.Slot,
+			statuses []string,
+		) ([]*types.ValidatorData[ValidatorT], error)
 		ValidatorBalancesByIDs(
 			slot math.Slot,
 			ids []string,
 		) ([]*types.ValidatorBalanceData, error)
+		ValidatorBalancesByIDsPaged(
+			slot math.Slot,
+			ids []string,
+			offset, limit uint64,
+		) ([]*types.ValidatorBalanceData, uint64, error)
 	}
 )
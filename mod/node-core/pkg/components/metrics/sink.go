@@ -42,7 +42,7 @@ func (TelemetrySink) IncrementCounter(key string, args ...string) {
 
 // SetGauge sets a gauge metric to the specified value, identified by the
 // provided keys.
-func (TelemetrySink) SetGauge(key string, value int64, args ...string) {
+func (TelemetrySink) SetGauge(key string, value float64, args ...string) {
 	telemetry.SetGaugeWithLabels(
 		[]string{key},
 		float32(value),
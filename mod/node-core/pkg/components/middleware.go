@@ -68,5 +68,9 @@ func ProvideABCIMiddleware[
 		in.Dispatcher,
 		in.Logger,
 		in.TelemetrySink,
+		middleware.AwaitTimeout,
+		middleware.BeaconBlockTxIndex,
+		middleware.BlobSidecarsTxIndex,
+		middleware.MaxBlockBytes,
 	), nil
 }
@@ -56,7 +56,7 @@ type ServiceRegistryInput[
 	BeaconStateMarshallableT any,
 	BlobSidecarT any,
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
-	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -125,7 +125,7 @@ func ProvideServiceRegistry[
 	BeaconStateMarshallableT any,
 	BlobSidecarT any,
 	BlobSidecarsT BlobSidecars[BlobSidecarsT, BlobSidecarT],
-	DepositT Deposit[DepositT, *ForkData, WithdrawalCredentials],
+	DepositT Deposit[DepositT, WithdrawalCredentials],
 	DepositStoreT DepositStore[DepositT],
 	ExecutionPayloadT ExecutionPayload[ExecutionPayloadT,
 		ExecutionPayloadHeaderT, WithdrawalsT],
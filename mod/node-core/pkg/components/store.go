@@ -53,5 +53,6 @@ func ProvideKVStore[
 		*Fork,
 		*Validator,
 		Validators,
+		WithdrawalCredentials,
 	](in.KVStoreService, payloadCodec)
 }
@@ -28,6 +28,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components/metrics"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
+	cmtcfg "github.com/cometbft/cometbft/config"
 )
 
 // ValidatorServiceInput is the input for the validator service provider.
@@ -49,6 +50,7 @@ type ValidatorServiceInput[
 	depinject.In
 	Cfg            *config.Config
 	ChainSpec      common.ChainSpec
+	CmtCfg         *cmtcfg.Config
 	Dispatcher     Dispatcher
 	LocalBuilder   LocalBuilder[BeaconStateT, ExecutionPayloadT]
 	Logger         LoggerT
@@ -105,6 +107,12 @@ func ProvideValidatorService[
 	*Eth1Data, ExecutionPayloadT, ExecutionPayloadHeaderT,
 	*ForkData, *SlashingInfo, *SlotData,
 ], error) {
+	// Fall back to the node's moniker when no graffiti is configured.
+	graffiti := in.Cfg.Validator.Graffiti
+	if graffiti == "" {
+		graffiti = in.CmtCfg.Moniker
+	}
+
 	// Build the builder service.
 	return validator.NewService[
 		*AttestationData,
@@ -122,6 +130,7 @@ func ProvideValidatorService[
 		*SlotData,
 	](
 		&in.Cfg.Validator,
+		graffiti,
 		in.Logger.With("service", "validator"),
 		in.ChainSpec,
 		in.StorageBackend,
@@ -25,6 +25,7 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	service "github.com/berachain/beacon-kit/mod/node-core/pkg/services/registry"
@@ -32,6 +33,10 @@ import (
 	"golang.org/x/sync/errgroup"
 )
 
+// shutdownTimeout is the maximum duration to wait for the services to stop
+// gracefully when the node shuts down.
+const shutdownTimeout = 10 * time.Second
+
 // Compile-time assertion that node implements the NodeI interface.
 var _ types.Node = (*node)(nil)
 
@@ -67,12 +72,28 @@ func (n *node) Start(
 	n.listenForQuitSignals(g, true, cancelFn)
 
 	// Start all the registered services.
-	if err := n.registry.StartAll(gctx); err != nil {
-		return err
+	err := n.registry.StartAll(gctx)
+	if err == nil {
+		// Wait for those aforementioned exit signals.
+		err = g.Wait()
 	}
 
-	// Wait for those aforementioned exit signals.
-	return g.Wait()
+	// Stop the services, including the ones already started if starting
+	// failed or was interrupted.
+	n.stopServices(ctx)
+	return err
+}
+
+// stopServices gracefully stops the services, e.g. to let in-flight prunes
+// complete, waiting at most shutdownTimeout.
+func (n *node) stopServices(ctx context.Context) {
+	sctx, cancelFn := context.WithTimeout(
+		context.WithoutCancel(ctx), shutdownTimeout,
+	)
+	defer cancelFn()
+	if err := n.registry.StopAll(sctx); err != nil {
+		n.logger.Error("failed to gracefully stop services", "error", err)
+	}
 }
 
 // listenForQuitSignals listens for SIGINT and SIGTERM. When a signal is
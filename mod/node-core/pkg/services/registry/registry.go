@@ -24,6 +24,7 @@ import (
 	"context"
 	"reflect"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 )
 
@@ -35,6 +36,14 @@ type Basic interface {
 	Name() string
 }
 
+// Closer is implemented by the services that must be closed gracefully when
+// the node shuts down.
+type Closer interface {
+	// Close stops the service, waiting for its in-flight work to complete or
+	// for the context to be done.
+	Close(ctx context.Context) error
+}
+
 type Dispatcher interface {
 	Start(ctx context.Context) error
 }
@@ -85,6 +94,27 @@ func (s *Registry) StartAll(ctx context.Context) error {
 	return nil
 }
 
+// StopAll closes the services implementing Closer in the reverse order of
+// their registration.
+func (s *Registry) StopAll(ctx context.Context) error {
+	var errs []error
+	for i := len(s.serviceTypes) - 1; i >= 0; i-- {
+		typeName := s.serviceTypes[i]
+		closer, ok := s.services[typeName].(Closer)
+		if !ok {
+			continue
+		}
+
+		s.logger.Info("Stopping service", "type", typeName)
+		if err := closer.Close(ctx); err != nil {
+			errs = append(errs, errors.Wrapf(
+				err, "failed to stop service %s", typeName,
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // RegisterService appends a service constructor function to the service
 // registry.
 func (s *Registry) RegisterService(service Basic) error {
@@ -22,6 +22,7 @@ package service_test
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -81,3 +82,38 @@ func TestRegistry_FetchService(t *testing.T) {
 		t.Errorf("Fetched service type mismatch")
 	}
 }
+
+// closingService is a service that must be closed on shutdown.
+type closingService struct {
+	*mocks.Basic
+	*mocks.Closer
+}
+
+func TestRegistry_StopAll(t *testing.T) {
+	logger := noop.NewLogger[any]()
+	registry := service.NewRegistry(service.WithLogger(logger))
+
+	var stopped []string
+	newClosingService := func(name string, err error) closingService {
+		svc := closingService{Basic: &mocks.Basic{}, Closer: &mocks.Closer{}}
+		svc.Basic.On("Name").Return(name)
+		svc.Closer.On("Close", mock.Anything).
+			Run(func(mock.Arguments) { stopped = append(stopped, name) }).
+			Return(err).Once()
+		return svc
+	}
+
+	errClose := errors.New("close failed")
+	service1 := newClosingService("Service1", errClose)
+	service2 := &mocks.Basic{}
+	service2.On("Name").Return("Service2")
+	service3 := newClosingService("Service3", nil)
+
+	require.NoError(t, registry.RegisterService(service1))
+	require.NoError(t, registry.RegisterService(service2))
+	require.NoError(t, registry.RegisterService(service3))
+
+	// The services are closed in reverse order and the errors are returned.
+	require.ErrorIs(t, registry.StopAll(context.Background()), errClose)
+	require.Equal(t, []string{"Service3", "Service1"}, stopped)
+}
@@ -58,7 +58,8 @@ func NewAttributesFactory[
 	}
 }
 
-// BuildPayloadAttributes creates a new instance of PayloadAttributes.
+// BuildPayloadAttributes creates a new instance of PayloadAttributes. The
+// optional overrides are applied in order on top of the derived attributes.
 func (f *Factory[
 	BeaconStateT,
 	PayloadAttributesT,
@@ -68,6 +69,7 @@ func (f *Factory[
 	slot math.Slot,
 	timestamp uint64,
 	prevHeadRoot [32]byte,
+	overrides ...PayloadAttributesOverrides,
 ) (PayloadAttributesT, error) {
 	var (
 		prevRandao [32]byte
@@ -97,7 +99,7 @@ func (f *Factory[
 		f.chainSpec.ActiveForkVersionForEpoch(epoch),
 		timestamp,
 		prevRandao,
-		f.suggestedFeeRecipient,
+		feeRecipient(f.suggestedFeeRecipient, overrides),
 		withdrawals,
 		prevHeadRoot,
 	)
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/chain-spec/pkg/chain"
+	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/stretchr/testify/require"
+)
+
+type mockBeaconState struct{}
+
+func (mockBeaconState) ExpectedWithdrawals() (
+	[]*engineprimitives.Withdrawal, error,
+) {
+	return []*engineprimitives.Withdrawal{}, nil
+}
+
+func (mockBeaconState) GetRandaoMixAtIndex(uint64) (common.Bytes32, error) {
+	return common.Bytes32{0x01}, nil
+}
+
+func TestBuildPayloadAttributesOverrides(t *testing.T) {
+	var (
+		defaultRecipient  = common.ExecutionAddress{0x01}
+		overrideRecipient = common.ExecutionAddress{0x02}
+	)
+	f := attributes.NewAttributesFactory[
+		mockBeaconState,
+		*engineprimitives.PayloadAttributes[*engineprimitives.Withdrawal],
+		*engineprimitives.Withdrawal,
+	](
+		chain.NewChainSpec(
+			chain.SpecData[
+				common.DomainType, math.Epoch, common.ExecutionAddress,
+				math.Slot, any,
+			]{
+				SlotsPerEpoch:             32,
+				EpochsPerHistoricalVector: 8,
+			},
+		),
+		noop.NewLogger[any](),
+		defaultRecipient,
+	)
+
+	tests := []struct {
+		name      string
+		overrides []attributes.PayloadAttributesOverrides
+		expected  common.ExecutionAddress
+	}{
+		{
+			name:     "no overrides",
+			expected: defaultRecipient,
+		},
+		{
+			name: "unset fee recipient",
+			overrides: []attributes.PayloadAttributesOverrides{
+				{},
+			},
+			expected: defaultRecipient,
+		},
+		{
+			name: "fee recipient override",
+			overrides: []attributes.PayloadAttributesOverrides{
+				{SuggestedFeeRecipient: &overrideRecipient},
+			},
+			expected: overrideRecipient,
+		},
+		{
+			name: "last override wins",
+			overrides: []attributes.PayloadAttributesOverrides{
+				{SuggestedFeeRecipient: &overrideRecipient},
+				{SuggestedFeeRecipient: &defaultRecipient},
+			},
+			expected: defaultRecipient,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs, err := f.BuildPayloadAttributes(
+				mockBeaconState{}, 1, 1, [32]byte{}, tt.overrides...,
+			)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, attrs.GetSuggestedFeeRecipient())
+		})
+	}
+}
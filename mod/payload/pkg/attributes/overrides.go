@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package attributes
+
+import "github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+
+// PayloadAttributesOverrides holds optional per-build overrides applied on
+// top of the payload attributes derived from the beacon state.
+type PayloadAttributesOverrides struct {
+	// SuggestedFeeRecipient, if set, replaces the fee recipient configured
+	// on the factory for this build.
+	SuggestedFeeRecipient *common.ExecutionAddress
+}
+
+// feeRecipient returns the fee recipient to use for a build, preferring the
+// last override that sets one over the given default.
+func feeRecipient(
+	def common.ExecutionAddress,
+	overrides []PayloadAttributesOverrides,
+) common.ExecutionAddress {
+	for _, o := range overrides {
+		if o.SuggestedFeeRecipient != nil {
+			def = *o.SuggestedFeeRecipient
+		}
+	}
+	return def
+}
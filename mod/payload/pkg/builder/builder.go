@@ -91,3 +91,21 @@ func (pb *PayloadBuilder[
 ]) Enabled() bool {
 	return pb.cfg.Enabled
 }
+
+// CachedPayloadIDs returns the IDs of the payloads currently being built on
+// the execution client, ordered by ascending slot.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) CachedPayloadIDs() []PayloadIDT {
+	return pb.pc.All()
+}
+
+// HasPayloadFor returns true if a payload build was already requested for
+// the given slot and parent block root.
+func (pb *PayloadBuilder[
+	BeaconStateT, ExecutionPayloadT, ExecutionPayloadHeaderT,
+	PayloadAttributesT, PayloadIDT, WithdrawalT,
+]) HasPayloadFor(slot math.Slot, parentBlockRoot common.Root) bool {
+	return pb.pc.Has(slot, parentBlockRoot)
+}
@@ -24,6 +24,7 @@ import (
 	"context"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
+	"github.com/berachain/beacon-kit/mod/payload/pkg/attributes"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
@@ -55,6 +56,7 @@ type BeaconState[
 type PayloadCache[PayloadIDT, RootT, SlotT any] interface {
 	Get(slot SlotT, stateRoot RootT) (PayloadIDT, bool)
 	Has(slot SlotT, stateRoot RootT) bool
+	All() []PayloadIDT
 	Set(slot SlotT, stateRoot RootT, pid PayloadIDT)
 	UnsafePrunePrior(slot SlotT)
 }
@@ -88,6 +90,7 @@ type AttributesFactory[
 		slot math.U64,
 		timestamp uint64,
 		prevHeadRoot [32]byte,
+		overrides ...attributes.PayloadAttributesOverrides,
 	) (PayloadAttributesT, error)
 }
 
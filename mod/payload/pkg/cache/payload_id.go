@@ -21,6 +21,8 @@
 package cache
 
 import (
+	"maps"
+	"slices"
 	"sync"
 )
 
@@ -84,6 +86,21 @@ func (p *PayloadIDCache[PayloadIDT, RootT, SlotT]) Get(
 	return pid, true
 }
 
+// All returns every cached payload ID, ordered by ascending slot.
+func (p *PayloadIDCache[PayloadIDT, _, _]) All() []PayloadIDT {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	pids := make([]PayloadIDT, 0, len(p.slotToStateRootToPayloadID))
+	for _, slot := range slices.Sorted(
+		maps.Keys(p.slotToStateRootToPayloadID),
+	) {
+		for _, pid := range p.slotToStateRootToPayloadID[slot] {
+			pids = append(pids, pid)
+		}
+	}
+	return pids
+}
+
 // Set updates or inserts a payload ID for a given slot and eth1 hash.
 // It also prunes entries in the cache that are older than the
 // historicalPayloadIDCacheSize limit.
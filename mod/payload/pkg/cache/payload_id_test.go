@@ -99,4 +99,16 @@ func TestPayloadIDCache(t *testing.T) {
 			require.True(t, ok, "Expected entry to exist for slot", slot)
 		}
 	})
+	t.Run("All ordered by slot", func(t *testing.T) {
+		c := cache.NewPayloadIDCache[[8]byte, [32]byte, uint64]()
+		require.Empty(t, c.All())
+
+		c.Set(11, [32]byte{2}, [8]byte{2})
+		c.Set(10, [32]byte{1}, [8]byte{1})
+		require.Equal(t, [][8]byte{{1}, {2}}, c.All())
+
+		// Setting a later slot prunes the oldest one.
+		c.Set(13, [32]byte{3}, [8]byte{3})
+		require.Equal(t, [][8]byte{{2}, {3}}, c.All())
+	})
 }
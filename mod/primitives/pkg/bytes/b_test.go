@@ -174,6 +174,13 @@ func TestBytesUnmarshalJSONText(t *testing.T) {
 	}
 }
 
+func TestBytesUnmarshalTextInvalidByte(t *testing.T) {
+	b := &bytes.Bytes{}
+	err := b.UnmarshalText([]byte("0x48zz"))
+	var invalidByteErr stdhex.InvalidByteError
+	require.ErrorAs(t, err, &invalidByteErr)
+}
+
 func TestReverseEndianness(t *testing.T) {
 	tests := []struct {
 		name     string
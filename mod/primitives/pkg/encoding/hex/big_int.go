@@ -70,6 +70,20 @@ func ToBigInt(hexStr string) (*big.Int, error) {
 	return dec, nil
 }
 
+// DecodeBigInt decodes a hex number with 0x prefix into a big.Int. Unlike
+// ToBigInt, the number is not limited to 256 bits.
+func DecodeBigInt(s string) (*big.Int, error) {
+	raw, err := formatAndValidateNumber(s)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateNibbles(raw); err != nil {
+		return nil, err
+	}
+	dec, _ := new(big.Int).SetString(raw, hexBase)
+	return dec, nil
+}
+
 // MustToBigInt decodes a hex string with 0x prefix.
 // It panics for invalid input.
 func MustToBigInt(hexStr string) *big.Int {
@@ -108,3 +108,34 @@ func TestString_MustToBigInt(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeBigInt(t *testing.T) {
+	above256Bits := new(big.Int).Lsh(big.NewInt(1), 256)
+	tests := []struct {
+		name     string
+		input    string
+		expected *big.Int
+		err      error
+	}{
+		{"Zero", "0x0", big.NewInt(0), nil},
+		{"Positive value", "0x3039", big.NewInt(12345), nil},
+		{"Above 256 bits", hex.FromBigInt(above256Bits), above256Bits, nil},
+		{"Leading zero", "0x01", nil, hex.ErrLeadingZero},
+		{"Empty number", "0x", nil, hex.ErrEmptyNumber},
+		{"Missing prefix", "3039", nil, hex.ErrMissingPrefix},
+		{"Invalid string", "0xinvalid", nil, hex.ErrInvalidString},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := hex.DecodeBigInt(tt.input)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.Nil(t, res)
+			} else {
+				require.NoError(t, err)
+				require.Zero(t, tt.expected.Cmp(res))
+			}
+		})
+	}
+}
@@ -26,7 +26,10 @@ import (
 	"github.com/berachain/beacon-kit/mod/errors"
 )
 
-var ErrInvalidHexStringLength = errors.New("invalid hex string length")
+var (
+	ErrInvalidHexStringLength = errors.New("invalid hex string length")
+	ErrBufferTooSmall         = errors.New("destination buffer too small")
+)
 
 // EncodeBytes creates a hex string with 0x prefix.
 // Inverse operation is ToBytes or MustToBytes.
@@ -37,6 +40,27 @@ func EncodeBytes(b []byte) string {
 	return string(hexStr)
 }
 
+// EncodeUpper creates an uppercase hex string with 0x prefix. The prefix
+// itself is kept lowercase so that the output remains decodable.
+func EncodeUpper(b []byte) string {
+	return EncodeWithCase(b, true)
+}
+
+// EncodeWithCase creates a hex string with 0x prefix, using uppercase digits
+// if upper is set and lowercase digits otherwise.
+func EncodeWithCase(b []byte, upper bool) string {
+	if !upper {
+		return EncodeBytes(b)
+	}
+	hexStr := make([]byte, len(b)*2+prefixLen)
+	copy(hexStr, Prefix)
+	for i, v := range b {
+		hexStr[prefixLen+i*2] = upperHexDigits[v>>nibbleShift]
+		hexStr[prefixLen+i*2+1] = upperHexDigits[v&lowNibbleMask]
+	}
+	return string(hexStr)
+}
+
 // MustToBytes returns the bytes represented by the given hex string.
 // It panics if the input is not a valid hex string.
 func MustToBytes(input string) []byte {
@@ -70,6 +94,41 @@ func UnmarshalByteText(input []byte) ([]byte, error) {
 	return dec, nil
 }
 
+// DecodeUnsafe decodes the input as a string with 0x prefix, skipping all
+// validation. The input must be 0x prefixed, of even length and contain only
+// hex digits; anything else yields garbage or a panic rather than an error.
+// WARNING: only use it on trusted data, such as hex this node encoded itself.
+// Use UnmarshalByteText for anything received from outside.
+func DecodeUnsafe(input []byte) []byte {
+	raw := input[prefixLen:]
+	dec := make([]byte, len(raw)/encDecRatio)
+	for i := range dec {
+		dec[i] = byte(decodeNibble(raw[i*2])<<nibbleShift |
+			decodeNibble(raw[i*2+1]))
+	}
+	return dec
+}
+
+// DecodeToExistingBuffer decodes the input as a string with 0x prefix into
+// dst without allocating, and returns the number of bytes written. An error
+// is returned if dst is too small to hold the decoded input, in which case
+// dst is left untouched. The same holds for inputs containing invalid
+// characters.
+func DecodeToExistingBuffer(dst, input []byte) (int, error) {
+	raw, err := validateDecodeLen(dst, input, false)
+	if err != nil {
+		return 0, err
+	}
+	// Pre-verify syntax so that dst is never partially written.
+	if err = validateNibbles(raw); err != nil {
+		return 0, err
+	}
+	if err = decodeRaw(dst, raw); err != nil {
+		return 0, err
+	}
+	return len(raw) / encDecRatio, nil
+}
+
 // DecodeFixedJSON decodes the input as a string with 0x prefix. The length
 // of out determines the required input length. This function is commonly used
 // to implement the UnmarshalJSON method for fixed-size types.
@@ -81,20 +140,66 @@ func DecodeFixedJSON(input, out []byte) error {
 	return DecodeFixedText(strippedInput, out)
 }
 
+// DecodeJSONQuoted decodes the input as a string with 0x prefix, stripping
+// the surrounding quotes if present. Inputs carrying only one of the two
+// quotes are rejected. As with UnmarshalByteText, an empty string decodes to
+// an empty slice. A nil slice is returned on error.
+func DecodeJSONQuoted(input []byte) ([]byte, error) {
+	strippedInput, err := ValidateQuotedString(input)
+	switch {
+	case err == nil:
+		input = strippedInput
+	case len(input) > 0 && (input[0] == '"' || input[len(input)-1] == '"'):
+		return nil, ErrNonQuotedString
+	}
+
+	dec, err := UnmarshalByteText(input)
+	if err != nil {
+		return nil, err
+	}
+	return dec, nil
+}
+
 // DecodeFixedText decodes the input as a string with 0x prefix. The length
 // of out determines the required input length.
 func DecodeFixedText(input, out []byte) error {
-	raw, err := formatAndValidateText(input)
+	raw, err := validateDecodeLen(out, input, true)
 	if err != nil {
 		return err
 	}
-	if len(raw)/encDecRatio != len(out) {
-		return errors.Wrapf(
+	return decodeRaw(out, raw)
+}
+
+// validateDecodeLen validates the input text and checks that its decoded
+// length fits dst. If exact is set, the decoded length must match len(dst),
+// otherwise it must not exceed it. The unprefixed input is returned.
+func validateDecodeLen(dst, input []byte, exact bool) ([]byte, error) {
+	raw, err := formatAndValidateText(input)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(raw) / encDecRatio
+	switch {
+	case exact && n != len(dst):
+		return nil, errors.Wrapf(
 			ErrInvalidHexStringLength,
 			"hex string has length %d, want %d",
-			len(raw), len(out)*encDecRatio,
+			len(raw), len(dst)*encDecRatio,
+		)
+	case n > len(dst):
+		return nil, errors.Wrapf(
+			ErrBufferTooSmall,
+			"decoded length is %d, buffer length is %d",
+			n, len(dst),
 		)
 	}
+	return raw, nil
+}
+
+// decodeRaw decodes the even length, unprefixed input into out, which must
+// be at least len(raw)/2 bytes long.
+func decodeRaw(out, raw []byte) error {
 	// Pre-verify syntax and decode in a single pass
 	for i := 0; i < len(raw); i += 2 {
 		highNibble := decodeNibble(raw[i])
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hex_test
+
+import (
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/stretchr/testify/require"
+)
+
+func FuzzDecodeUnsafe(f *testing.F) {
+	f.Add([]byte{}, false)
+	f.Add([]byte{0x00}, false)
+	f.Add([]byte{0xde, 0xad, 0xbe, 0xef}, true)
+	f.Add([]byte{0x0f, 0xf0, 0xa5, 0x5a, 0x99}, false)
+
+	f.Fuzz(func(t *testing.T, input []byte, upper bool) {
+		enc := []byte(hex.EncodeWithCase(input, upper))
+
+		expected, err := hex.UnmarshalByteText(enc)
+		require.NoError(t, err)
+		require.Equal(t, expected, hex.DecodeUnsafe(enc))
+		require.Equal(t, input, hex.DecodeUnsafe(enc))
+	})
+}
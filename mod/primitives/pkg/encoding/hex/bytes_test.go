@@ -81,6 +81,53 @@ func TestEncodeAndDecodeBytes(t *testing.T) {
 	}
 }
 
+func TestEncodeWithCase(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []byte
+		expectedUpper string
+		expectedLower string
+	}{
+		{
+			name:          "typical byte slice",
+			input:         []byte{0xde, 0xad, 0xbe, 0xef, 0x01},
+			expectedUpper: "0xDEADBEEF01",
+			expectedLower: "0xdeadbeef01",
+		},
+		{
+			name:          "empty byte slice",
+			input:         []byte{},
+			expectedUpper: "0x",
+			expectedLower: "0x",
+		},
+		{
+			name:          "digits only",
+			input:         []byte{0x12, 0x34},
+			expectedUpper: "0x1234",
+			expectedLower: "0x1234",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upper := hex.EncodeUpper(tt.input)
+			require.Equal(t, tt.expectedUpper, upper)
+			require.Equal(t, upper, hex.EncodeWithCase(tt.input, true))
+			require.Equal(
+				t, tt.expectedLower, hex.EncodeWithCase(tt.input, false),
+			)
+
+			decoded, err := hex.ToBytes(upper)
+			require.NoError(t, err)
+			require.Equal(t, tt.input, decoded)
+
+			decoded, err = hex.UnmarshalByteText([]byte(upper))
+			require.NoError(t, err)
+			require.Equal(t, tt.input, decoded)
+		})
+	}
+}
+
 func TestUnmarshalByteText(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -127,6 +174,74 @@ func TestUnmarshalByteText(t *testing.T) {
 	}
 }
 
+func TestDecodeJSONQuoted(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		expected  []byte
+		expectErr error
+	}{
+		{
+			name:     "quoted hex string",
+			input:    []byte(`"0x48656c6c6f"`),
+			expected: []byte{0x48, 0x65, 0x6c, 0x6c, 0x6f},
+		},
+		{
+			name:     "unquoted hex string",
+			input:    []byte("0x48656c6c6f"),
+			expected: []byte{0x48, 0x65, 0x6c, 0x6c, 0x6f},
+		},
+		{
+			name:     "quoted empty hex string",
+			input:    []byte(`"0x"`),
+			expected: []byte{},
+		},
+		{
+			name:     "empty quoted string",
+			input:    []byte(`""`),
+			expected: []byte{},
+		},
+		{
+			name:      "leading quote only",
+			input:     []byte(`"0x4865`),
+			expectErr: hex.ErrNonQuotedString,
+		},
+		{
+			name:      "trailing quote only",
+			input:     []byte(`0x4865"`),
+			expectErr: hex.ErrNonQuotedString,
+		},
+		{
+			name:      "single quote character",
+			input:     []byte(`"`),
+			expectErr: hex.ErrNonQuotedString,
+		},
+		{
+			name:      "quoted odd length",
+			input:     []byte(`"0x123"`),
+			expectErr: hex.ErrOddLength,
+		},
+		{
+			name:      "quoted missing prefix",
+			input:     []byte(`"4865"`),
+			expectErr: hex.ErrMissingPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := hex.DecodeJSONQuoted(tt.input)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.Nil(t, result)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestDecodeFixedText(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -233,6 +348,97 @@ func TestDecodeFixedJSON(t *testing.T) {
 	}
 }
 
+func TestDecodeToExistingBuffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []byte
+		dstLen    int
+		expected  []byte
+		expectErr error
+	}{
+		{
+			name:     "exact buffer",
+			input:    []byte("0x48656c6c6f"),
+			dstLen:   5,
+			expected: []byte{0x48, 0x65, 0x6c, 0x6c, 0x6f},
+		},
+		{
+			name:     "larger buffer",
+			input:    []byte("0x4865"),
+			dstLen:   4,
+			expected: []byte{0x48, 0x65},
+		},
+		{
+			name:     "empty hex string",
+			input:    []byte("0x"),
+			dstLen:   0,
+			expected: []byte{},
+		},
+		{
+			name:      "buffer too small",
+			input:     []byte("0x48656c6c6f"),
+			dstLen:    4,
+			expectErr: hex.ErrBufferTooSmall,
+		},
+		{
+			name:      "odd length",
+			input:     []byte("0x123"),
+			dstLen:    2,
+			expectErr: hex.ErrOddLength,
+		},
+		{
+			name:      "invalid characters",
+			input:     []byte("0xzzzz"),
+			dstLen:    2,
+			expectErr: hex.ErrInvalidString,
+		},
+		{
+			name:      "missing prefix",
+			input:     []byte("4865"),
+			dstLen:    2,
+			expectErr: hex.ErrMissingPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst := make([]byte, tt.dstLen)
+			n, err := hex.DecodeToExistingBuffer(dst, tt.input)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.Zero(t, n)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, len(tt.expected), n)
+			require.Equal(t, tt.expected, dst[:n])
+		})
+	}
+}
+
+func TestDecodeToExistingBufferLeavesDstOnError(t *testing.T) {
+	for _, input := range []string{"0x4865zz", "0x48656c"} {
+		t.Run(input, func(t *testing.T) {
+			dst := []byte{0xaa, 0xbb}
+			n, err := hex.DecodeToExistingBuffer(dst, []byte(input))
+			require.Error(t, err)
+			require.Zero(t, n)
+			require.Equal(t, []byte{0xaa, 0xbb}, dst)
+		})
+	}
+}
+
+func TestDecodeToExistingBufferAllocs(t *testing.T) {
+	input := []byte("0x48656c6c6f48656c6c6f48656c6c6f48656c6c6f")
+	dst := make([]byte, 32)
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := hex.DecodeToExistingBuffer(dst, input); err != nil {
+			t.Fatal(err)
+		}
+	})
+	require.Zero(t, allocs)
+}
+
 func BenchmarkDecodeFixedText(b *testing.B) {
 	sizes := []int{100, 1000, 10000} // Different input sizes
 
@@ -264,3 +470,28 @@ func BenchmarkDecodeFixedText(b *testing.B) {
 		})
 	}
 }
+
+func BenchmarkDecodeToExistingBuffer(b *testing.B) {
+	sizes := []int{100, 1000, 10000}
+
+	for _, size := range sizes {
+		b.Run("Size"+strconv.Itoa(size), func(b *testing.B) {
+			input := make([]byte, size*2+2)
+			input[0] = '0'
+			input[1] = 'x'
+			for i := 2; i < len(input); i += 2 {
+				input[i] = 'a'
+				input[i+1] = 'f'
+			}
+			dst := make([]byte, size)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := hex.DecodeToExistingBuffer(dst, input); err != nil {
+					b.Fatalf("DecodeToExistingBuffer failed: %v", err)
+				}
+			}
+		})
+	}
+}
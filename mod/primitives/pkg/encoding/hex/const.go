@@ -30,9 +30,13 @@ const (
 	nibblesPer64Bits  = 16 // 64/4
 	nibblesPer256Bits = 64 // 256/4
 	nibbleShift       = 4
+	lowNibbleMask     = 0x0f
 
 	// hexadecimal conversion constants.
 	hexBaseOffset       = '0'
 	hexAlphaOffsetUpper = 'A' - 10
 	hexAlphaOffsetLower = 'a' - 10
+
+	// upperHexDigits is the uppercase hexadecimal alphabet.
+	upperHexDigits = "0123456789ABCDEF"
 )
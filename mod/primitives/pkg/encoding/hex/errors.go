@@ -33,6 +33,7 @@ var (
 	ErrLeadingZero        = errors.New("hex number with leading zero digits")
 	ErrEmptyNumber        = errors.New("hex string \"0x\"")
 	ErrUint64Range        = errors.New("hex number > 64 bits")
+	ErrUint64Overflow     = errors.New("hex number overflows uint64")
 	ErrBig256Range        = errors.New("hex number > 256 bits")
 	ErrInvalidBigWordSize = errors.New("weird big.Word size")
 )
@@ -50,17 +50,41 @@ func ValidateQuotedString(input []byte) ([]byte, error) {
 	return nil, ErrNonQuotedString
 }
 
+// ValidateString checks that s is a well-formed 0x prefixed hex string of
+// even length, without decoding it. Unlike the text decoders, an empty s is
+// rejected with ErrEmptyString.
+func ValidateString(s string) error {
+	if len(s) == 0 {
+		return ErrEmptyString
+	}
+	raw, err := formatAndValidateText(s)
+	if err != nil {
+		return err
+	}
+	return validateNibbles(raw)
+}
+
+// ValidateNumber checks that s is a well-formed 0x prefixed hex number,
+// without decoding it.
+func ValidateNumber(s string) error {
+	raw, err := formatAndValidateNumber(s)
+	if err != nil {
+		return err
+	}
+	return validateNibbles(raw)
+}
+
 // formatAndValidateText validates the input text for a hex string.
-func formatAndValidateText(input []byte) ([]byte, error) {
+func formatAndValidateText[T []byte | string](input T) (T, error) {
 	input, err := IsValidHex(input)
 	if errors.Is(err, ErrEmptyString) {
-		return nil, nil // empty strings are allowed
+		return *new(T), nil // empty strings are allowed
 	} else if err != nil {
-		return nil, err
+		return *new(T), err
 	}
 
 	if len(input)%2 != 0 {
-		return nil, ErrOddLength
+		return *new(T), ErrOddLength
 	}
 	return input, nil
 }
@@ -80,3 +104,13 @@ func formatAndValidateNumber[T []byte | string](input T) (T, error) {
 	}
 	return input, nil
 }
+
+// validateNibbles checks that every character of raw is a hex digit.
+func validateNibbles[T []byte | string](raw T) error {
+	for i := range len(raw) {
+		if decodeNibble(raw[i]) == badNibble {
+			return ErrInvalidString
+		}
+	}
+	return nil
+}
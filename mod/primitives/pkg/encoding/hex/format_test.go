@@ -71,3 +71,58 @@ func TestIsValidHex(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateString(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"Valid hex string", "0x48656c6c6f", nil},
+		{"Uppercase hex string", "0X48656C6C6F", nil},
+		{"Empty hex string", "0x", nil},
+		{"Empty string", "", hex.ErrEmptyString},
+		{"No 0x prefix", "48656c6c6f", hex.ErrMissingPrefix},
+		{"Odd length", "0x123", hex.ErrOddLength},
+		{"Invalid characters", "0x12zz", hex.ErrInvalidString},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := hex.ValidateString(test.input)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"Valid number", "0x1a", nil},
+		{"Zero", "0x0", nil},
+		{"Odd length is allowed", "0x123", nil},
+		{"Empty number", "0x", hex.ErrEmptyNumber},
+		{"Empty string", "", hex.ErrEmptyString},
+		{"Leading zero", "0x01", hex.ErrLeadingZero},
+		{"No 0x prefix", "1a", hex.ErrMissingPrefix},
+		{"Invalid characters", "0x1g", hex.ErrInvalidString},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := hex.ValidateNumber(test.input)
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
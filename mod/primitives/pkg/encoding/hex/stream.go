@@ -0,0 +1,113 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hex
+
+import (
+	"errors"
+	"io"
+	"strings"
+)
+
+// streamBufferSize is the maximum number of hex characters read from the
+// underlying reader at once.
+const streamBufferSize = 4096
+
+// decoder is an io.Reader that decodes a 0x prefixed hex stream.
+type decoder struct {
+	r   io.Reader
+	err error
+	in  [streamBufferSize]byte
+	// prefixRead is set once the 0x prefix has been consumed.
+	prefixRead bool
+	// nibble holds the high nibble of a byte split across reads.
+	nibble    uint64
+	hasNibble bool
+}
+
+// NewDecoder returns an io.Reader that decodes the 0x prefixed hex stream
+// read from r. Characters are validated as they are read, so an invalid
+// character yields ErrInvalidString once the bytes preceding it have been
+// returned. An odd number of hex characters yields ErrOddLength at EOF.
+func NewDecoder(r io.Reader) io.Reader {
+	return &decoder{r: r}
+}
+
+// Read implements io.Reader.
+func (d *decoder) Read(p []byte) (int, error) {
+	if !d.prefixRead && d.err == nil {
+		d.err = d.readPrefix()
+	}
+
+	var n int
+	for n == 0 && d.err == nil && len(p) > 0 {
+		m, err := d.r.Read(d.in[:min(len(p)*encDecRatio, len(d.in))])
+		n += d.decode(p[n:], d.in[:m])
+		if d.err != nil || err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) && d.hasNibble {
+			err = ErrOddLength
+		}
+		d.err = err
+	}
+
+	if n > 0 {
+		return n, nil
+	}
+	return 0, d.err
+}
+
+// decode decodes the hex characters in src into dst, carrying a trailing
+// nibble over to the next call, and returns the number of bytes written.
+func (d *decoder) decode(dst, src []byte) int {
+	var n int
+	for _, c := range src {
+		nib := decodeNibble(c)
+		if nib == badNibble {
+			d.err = ErrInvalidString
+			return n
+		}
+		if !d.hasNibble {
+			d.nibble, d.hasNibble = nib, true
+			continue
+		}
+		dst[n] = byte((d.nibble << nibbleShift) | nib)
+		n++
+		d.hasNibble = false
+	}
+	return n
+}
+
+// readPrefix consumes and validates the 0x prefix. An empty stream is
+// allowed and decodes to no bytes, mirroring UnmarshalByteText.
+func (d *decoder) readPrefix() error {
+	var prefix [prefixLen]byte
+	switch _, err := io.ReadFull(d.r, prefix[:]); {
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return ErrMissingPrefix
+	case err != nil:
+		return err
+	case strings.ToLower(string(prefix[:])) != Prefix:
+		return ErrMissingPrefix
+	}
+	d.prefixRead = true
+	return nil
+}
@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package hex_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecoder(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  []byte
+		expectErr error
+	}{
+		{
+			name:     "valid hex string",
+			input:    "0x48656c6c6f",
+			expected: []byte{0x48, 0x65, 0x6c, 0x6c, 0x6f},
+		},
+		{
+			name:     "mixed case",
+			input:    "0XdeADbeEF",
+			expected: []byte{0xde, 0xad, 0xbe, 0xef},
+		},
+		{
+			name:     "empty hex string",
+			input:    "0x",
+			expected: []byte{},
+		},
+		{
+			name:     "empty input",
+			input:    "",
+			expected: []byte{},
+		},
+		{
+			name:      "missing prefix",
+			input:     "48656c6c6f",
+			expected:  []byte{},
+			expectErr: hex.ErrMissingPrefix,
+		},
+		{
+			name:      "truncated prefix",
+			input:     "0",
+			expected:  []byte{},
+			expectErr: hex.ErrMissingPrefix,
+		},
+		{
+			name:      "odd length",
+			input:     "0x48656c6c6",
+			expected:  []byte{0x48, 0x65, 0x6c, 0x6c},
+			expectErr: hex.ErrOddLength,
+		},
+		{
+			name:      "invalid character mid-stream",
+			input:     "0x4865zz6c",
+			expected:  []byte{0x48, 0x65},
+			expectErr: hex.ErrInvalidString,
+		},
+	}
+
+	readers := map[string]func(io.Reader) io.Reader{
+		"whole":    func(r io.Reader) io.Reader { return r },
+		"one byte": iotest.OneByteReader,
+		"data err": iotest.DataErrReader,
+	}
+
+	for _, tt := range tests {
+		for readerName, wrap := range readers {
+			t.Run(tt.name+"/"+readerName, func(t *testing.T) {
+				dec := hex.NewDecoder(wrap(strings.NewReader(tt.input)))
+				var out bytes.Buffer
+				_, err := io.Copy(&out, dec)
+				if tt.expectErr != nil {
+					require.ErrorIs(t, err, tt.expectErr)
+				} else {
+					require.NoError(t, err)
+				}
+				require.Equal(t, tt.expected, out.Bytes())
+			})
+		}
+	}
+}
+
+func TestNewDecoderLargeInput(t *testing.T) {
+	expected := bytes.Repeat([]byte{0xab, 0xcd, 0xef}, 10000)
+	input := hex.EncodeBytes(expected)
+
+	decoded, err := io.ReadAll(hex.NewDecoder(strings.NewReader(input)))
+	require.NoError(t, err)
+	require.Equal(t, expected, decoded)
+}
@@ -58,3 +58,19 @@ func UnmarshalUint64Text(input []byte) (uint64, error) {
 	}
 	return dec, nil
 }
+
+// DecodeUint64 decodes a hex number with 0x prefix into a uint64. Numbers
+// that do not fit into 64 bits are rejected with ErrUint64Overflow.
+func DecodeUint64(s string) (uint64, error) {
+	raw, err := formatAndValidateNumber(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(raw) > nibblesPer64Bits {
+		return 0, ErrUint64Overflow
+	}
+	if err = validateNibbles(raw); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(raw, hexBase, 64) //nolint:mnd // 64 bits.
+}
@@ -106,3 +106,34 @@ func TestUnmarshalUint64Text(t *testing.T) {
 		})
 	}
 }
+
+func TestDecodeUint64(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected uint64
+		err      error
+	}{
+		{"Zero", "0x0", 0, nil},
+		{"MaxByte", "0xff", 255, nil},
+		{"UpperCase", "0xFF", 255, nil},
+		{"MaxQWord", "0xffffffffffffffff", 18446744073709551615, nil},
+		{"Overflow", "0x10000000000000000", 0, hex.ErrUint64Overflow},
+		{"LeadingZero", "0x01", 0, hex.ErrLeadingZero},
+		{"EmptyNumber", "0x", 0, hex.ErrEmptyNumber},
+		{"MissingPrefix", "ff", 0, hex.ErrMissingPrefix},
+		{"InvalidString", "0xzz", 0, hex.ErrInvalidString},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result, err := hex.DecodeUint64(test.input)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, test.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+)
+
+// CachedRoot wraps an SSZ type and memoizes its hash tree root, so that hot
+// types can opt into caching their root without implementing it themselves.
+//
+// The cached root must be invalidated with Invalidate whenever the wrapped
+// value is modified in place. A CachedRoot is not safe for concurrent use.
+type CachedRoot[T constraints.SSZMarshallableRootable] struct {
+	// value is the wrapped value.
+	value T
+	// root is the cached hash tree root of the value, nil if not computed.
+	root *common.Root
+}
+
+// NewCachedRoot returns a CachedRoot wrapping the given value.
+func NewCachedRoot[T constraints.SSZMarshallableRootable](
+	value T,
+) *CachedRoot[T] {
+	return &CachedRoot[T]{value: value}
+}
+
+// Value returns the wrapped value.
+func (c *CachedRoot[T]) Value() T {
+	return c.value
+}
+
+// HashTreeRoot returns the hash tree root of the wrapped value, computing it
+// only if it is not cached yet.
+func (c *CachedRoot[T]) HashTreeRoot() common.Root {
+	if c.root == nil {
+		root := c.value.HashTreeRoot()
+		c.root = &root
+	}
+	return *c.root
+}
+
+// Invalidate drops the cached root, which is recomputed on the next call to
+// HashTreeRoot.
+func (c *CachedRoot[T]) Invalidate() {
+	c.root = nil
+}
+
+// MarshalSSZ marshals the wrapped value into SSZ format.
+func (c *CachedRoot[T]) MarshalSSZ() ([]byte, error) {
+	return c.value.MarshalSSZ()
+}
+
+// UnmarshalSSZ unmarshals the wrapped value from SSZ format, invalidating the
+// cached root.
+func (c *CachedRoot[T]) UnmarshalSSZ(bz []byte) error {
+	c.Invalidate()
+	return c.value.UnmarshalSSZ(bz)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+// countingRootable is a test value that counts how often its root is
+// computed.
+type countingRootable struct {
+	value  uint64
+	hashes int
+}
+
+func (c *countingRootable) MarshalSSZ() ([]byte, error) {
+	return binary.LittleEndian.AppendUint64(nil, c.value), nil
+}
+
+func (c *countingRootable) UnmarshalSSZ(bz []byte) error {
+	c.value = binary.LittleEndian.Uint64(bz)
+	return nil
+}
+
+func (c *countingRootable) HashTreeRoot() common.Root {
+	c.hashes++
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:], c.value)
+	return root
+}
+
+func TestCachedRoot(t *testing.T) {
+	value := &countingRootable{value: 1}
+	cached := ssz.NewCachedRoot(value)
+	require.Same(t, value, cached.Value())
+
+	root := cached.HashTreeRoot()
+	require.Equal(t, (&countingRootable{value: 1}).HashTreeRoot(), root)
+	require.Equal(t, 1, value.hashes)
+
+	// The root is memoized until it is invalidated.
+	require.Equal(t, root, cached.HashTreeRoot())
+	require.Equal(t, 1, value.hashes)
+
+	value.value = 2
+	require.Equal(t, root, cached.HashTreeRoot())
+	cached.Invalidate()
+	require.NotEqual(t, root, cached.HashTreeRoot())
+	require.Equal(t, 2, value.hashes)
+
+	// Unmarshalling replaces the value and thus invalidates the root.
+	bz, err := (&countingRootable{value: 3}).MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, cached.UnmarshalSSZ(bz))
+	require.Equal(t, (&countingRootable{value: 3}).HashTreeRoot(),
+		cached.HashTreeRoot())
+	require.Equal(t, 3, value.hashes)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz
+
+import (
+	"github.com/berachain/beacon-kit/mod/errors"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+)
+
+// ErrListExceedsLimit is returned when a list holds more elements than its
+// limit allows.
+var ErrListExceedsLimit = errors.New("list length exceeds limit")
+
+// ListRootWithLimit returns the hash tree root of items as an SSZ list of
+// composite elements bounded by limit, i.e. the merkleized element roots
+// mixed in with the list length.
+func ListRootWithLimit[T constraints.SSZRootable](
+	items []T,
+	limit uint64,
+) (common.Root, error) {
+	count := uint64(len(items))
+	if count > limit {
+		return common.Root{}, errors.Wrapf(
+			ErrListExceedsLimit, "got %d elements, limit is %d", count, limit,
+		)
+	}
+
+	leaves := make([]common.Root, count)
+	for i, item := range items {
+		leaves[i] = item.HashTreeRoot()
+	}
+
+	hasher := merkle.NewHasher[common.Root](sha256.Hash)
+	root, err := merkle.NewRootHasher(
+		hasher, merkle.BuildParentTreeRoots[common.Root],
+	).NewRootWithMaxLeaves(leaves, math.U64(limit))
+	if err != nil {
+		return common.Root{}, err
+	}
+	return hasher.MixIn(root, count), nil
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package ssz_test
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/eip4844"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/ssz"
+	"github.com/stretchr/testify/require"
+)
+
+// uint64Root is a test element whose root is its little endian encoding.
+type uint64Root uint64
+
+func (u uint64Root) HashTreeRoot() common.Root {
+	var root common.Root
+	binary.LittleEndian.PutUint64(root[:], uint64(u))
+	return root
+}
+
+func TestListRootWithLimit(t *testing.T) {
+	t.Run("kzg commitments", func(t *testing.T) {
+		items := make([]eip4844.KZGCommitment, 3)
+		for i := range items {
+			for j := range items[i] {
+				items[i][j] = byte(i + 1)
+			}
+		}
+		root, err := ssz.ListRootWithLimit(items, 16)
+		require.NoError(t, err)
+		require.Equal(t, mustRoot(t,
+			"0xeca7dcd7845a4b1a3bef6c6333c38f4b26ef78f7978ac10e53f91bd68aac033f",
+		), root)
+	})
+
+	tests := []struct {
+		name     string
+		items    []uint64Root
+		limit    uint64
+		expected string
+	}{
+		{
+			name:     "empty",
+			items:    []uint64Root{},
+			limit:    8,
+			expected: "0xe8e527e84f666163a90ef900e013f56b0a4d020148b2224057b719f351b003a6",
+		},
+		{
+			name:     "partial",
+			items:    []uint64Root{1, 2, 3, 4, 5},
+			limit:    8,
+			expected: "0x3107bc83bdc44ffcf91ea6d1b322f3e84656ef7641de848617d61696c077fe8d",
+		},
+		{
+			name:     "single element at limit",
+			items:    []uint64Root{7},
+			limit:    1,
+			expected: "0x1bbc0245c9ac49e3096b351ad366854d62d5356ee6ec711da2ebe657d35718b2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ssz.ListRootWithLimit(tt.items, tt.limit)
+			require.NoError(t, err)
+			require.Equal(t, mustRoot(t, tt.expected), root)
+		})
+	}
+
+	t.Run("exceeds limit", func(t *testing.T) {
+		_, err := ssz.ListRootWithLimit([]uint64Root{1, 2, 3}, 2)
+		require.ErrorIs(t, err, ssz.ErrListExceedsLimit)
+	})
+}
+
+func mustRoot(t *testing.T, s string) common.Root {
+	t.Helper()
+	root, err := common.NewRootFromHex(s)
+	require.NoError(t, err)
+	return root
+}
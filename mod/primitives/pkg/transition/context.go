@@ -20,7 +20,10 @@
 
 package transition
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Context is the context for the state transition.
 type Context struct {
@@ -38,6 +41,23 @@ type Context struct {
 	// SkipValidateResult indicates whether to validate the result of
 	// the state transition.
 	SkipValidateResult bool
+	// ProcessingDeadline is the time by which the state transition must be
+	// processed. The zero value means there is no deadline.
+	ProcessingDeadline time.Time
+}
+
+// WithProcessingDeadline returns a copy of the context with the given
+// processing deadline.
+func (c *Context) WithProcessingDeadline(t time.Time) *Context {
+	cpy := *c
+	cpy.ProcessingDeadline = t
+	return &cpy
+}
+
+// GetProcessingDeadline returns the time by which the state transition must be
+// processed, and whether a deadline is set.
+func (c *Context) GetProcessingDeadline() (time.Time, bool) {
+	return c.ProcessingDeadline, !c.ProcessingDeadline.IsZero()
 }
 
 // GetOptimisticEngine returns whether to optimistically assume the execution
@@ -69,3 +89,20 @@ func (c *Context) GetSkipValidateResult() bool {
 func (c *Context) Unwrap() context.Context {
 	return c.Context
 }
+
+// simulationKey is the context key marking a state transition as a simulation.
+type simulationKey struct{}
+
+// WithSimulation returns a copy of the given context marking the state
+// transition run with it as a simulation, whose side effects outside of the
+// state, e.g. forkchoice updates, must be skipped.
+func WithSimulation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, simulationKey{}, true)
+}
+
+// IsSimulation returns whether the given context marks the state transition
+// as a simulation.
+func IsSimulation(ctx context.Context) bool {
+	simulation, _ := ctx.Value(simulationKey{}).(bool)
+	return simulation
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package transition_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/transition"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContext_ProcessingDeadline(t *testing.T) {
+	ctx := &transition.Context{
+		Context:            context.Background(),
+		SkipValidateRandao: true,
+	}
+	_, ok := ctx.GetProcessingDeadline()
+	require.False(t, ok)
+
+	deadline := time.Now().Add(time.Second)
+	withDeadline := ctx.WithProcessingDeadline(deadline)
+
+	got, ok := withDeadline.GetProcessingDeadline()
+	require.True(t, ok)
+	require.Equal(t, deadline, got)
+	require.True(t, withDeadline.GetSkipValidateRandao())
+
+	// The original context is left untouched.
+	_, ok = ctx.GetProcessingDeadline()
+	require.False(t, ok)
+}
+
+func TestSimulation(t *testing.T) {
+	ctx := context.Background()
+	require.False(t, transition.IsSimulation(ctx))
+
+	simCtx := transition.WithSimulation(ctx)
+	require.True(t, transition.IsSimulation(simCtx))
+
+	// The marker is inherited by derived contexts.
+	derived, cancel := context.WithCancel(simCtx)
+	defer cancel()
+	require.True(t, transition.IsSimulation(derived))
+	require.True(t, transition.IsSimulation(
+		&transition.Context{Context: simCtx},
+	))
+}
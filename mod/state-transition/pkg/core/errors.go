@@ -74,4 +74,9 @@ var (
 	// ErrNumWithdrawalsMismatch is returned when the number of withdrawals
 	// in a block does not match the expected value.
 	ErrNumWithdrawalsMismatch = errors.New("number of withdrawals mismatch")
+
+	// ErrTransitionDeadlineExceeded is returned when the state transition is
+	// not processed by the processing deadline of its context.
+	ErrTransitionDeadlineExceeded = errors.New(
+		"state transition deadline exceeded")
 )
@@ -76,7 +76,9 @@ type ReadOnlyBeaconState[
 	GetBlockRootAtIndex(uint64) (common.Root, error)
 	GetLatestBlockHeader() (BeaconBlockHeaderT, error)
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
+	GetValidatorChurnLimit(math.Epoch) (uint64, error)
 	GetValidators() (ValidatorsT, error)
+	GetValidatorsRoot() (common.Root, error)
 	GetSlashingAtIndex(uint64) (math.Gwei, error)
 	GetTotalSlashing() (math.Gwei, error)
 	GetNextWithdrawalIndex() (uint64, error)
@@ -86,6 +88,9 @@ type ReadOnlyBeaconState[
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	CometBFTAddressByValidatorIndex(
+		idx math.ValidatorIndex,
+	) ([]byte, error)
 }
 
 // WriteOnlyBeaconState is the interface for a write-only beacon state.
@@ -105,6 +110,8 @@ type WriteOnlyBeaconState[
 	SetLatestBlockHeader(BeaconBlockHeaderT) error
 	IncreaseBalance(math.ValidatorIndex, math.Gwei) error
 	DecreaseBalance(math.ValidatorIndex, math.Gwei) error
+	IncreaseBalances(map[math.ValidatorIndex]math.Gwei) error
+	DecreaseBalances(map[math.ValidatorIndex]math.Gwei) error
 	UpdateSlashingAtIndex(uint64, math.Gwei) error
 	SetNextWithdrawalIndex(uint64) error
 	SetNextWithdrawalValidatorIndex(math.ValidatorIndex) error
@@ -155,6 +162,10 @@ type ReadOnlyValidators[ValidatorT any] interface {
 	ValidatorByIndex(
 		math.ValidatorIndex,
 	) (ValidatorT, error)
+
+	GetActiveValidatorIndices(
+		math.Epoch,
+	) ([]math.ValidatorIndex, error)
 }
 
 // WriteOnlyEth1Data has write access to eth1 data.
@@ -178,4 +189,5 @@ type ReadOnlyEth1Data[Eth1DataT, ExecutionPayloadHeaderT any] interface {
 // ReadOnlyWithdrawals only has read access to withdrawal methods.
 type ReadOnlyWithdrawals[WithdrawalT any] interface {
 	ExpectedWithdrawals() ([]WithdrawalT, error)
+	ExpectedWithdrawalsAtSlot(slot math.Slot) ([]WithdrawalT, error)
 }
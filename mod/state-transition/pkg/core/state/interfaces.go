@@ -89,6 +89,8 @@ type KVStore[
 	SetEth1Data(data Eth1DataT) error
 	// GetValidators retrieves all validators.
 	GetValidators() (ValidatorsT, error)
+	// GetValidatorsRoot retrieves the hash tree root of all validators.
+	GetValidatorsRoot() (common.Root, error)
 	// GetBalances retrieves all balances.
 	GetBalances() ([]uint64, error)
 	// GetNextWithdrawalIndex retrieves the next withdrawal index.
@@ -118,6 +120,14 @@ type KVStore[
 	GetTotalActiveBalances(uint64) (math.Gwei, error)
 	// ValidatorByIndex retrieves the validator at the given index.
 	ValidatorByIndex(index math.ValidatorIndex) (ValidatorT, error)
+	// ValidatorIndicesByWithdrawalCredentials retrieves the indices of the
+	// validators with the given withdrawal credentials.
+	ValidatorIndicesByWithdrawalCredentials(
+		wc common.Bytes32,
+	) ([]math.ValidatorIndex, error)
+	// GetActiveValidatorIndices retrieves the indices of the validators
+	// active at the given epoch.
+	GetActiveValidatorIndices(epoch math.Epoch) ([]math.ValidatorIndex, error)
 	// UpdateBlockRootAtIndex updates the block root at the given index.
 	UpdateBlockRootAtIndex(index uint64, root common.Root) error
 	// UpdateStateRootAtIndex updates the state root at the given index.
@@ -140,6 +150,11 @@ type KVStore[
 	ValidatorIndexByCometBFTAddress(
 		cometBFTAddress []byte,
 	) (math.ValidatorIndex, error)
+	// CometBFTAddressByValidatorIndex retrieves the comet BFT address of the
+	// validator at the given index.
+	CometBFTAddressByValidatorIndex(
+		idx math.ValidatorIndex,
+	) ([]byte, error)
 	// GetValidatorsByEffectiveBalance retrieves validators by effective
 	// balance.
 	GetValidatorsByEffectiveBalance() ([]ValidatorT, error)
@@ -21,6 +21,9 @@
 package state
 
 import (
+	"maps"
+	"slices"
+
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -153,6 +156,60 @@ func (s *StateDB[
 	return s.SetBalance(idx, balance-min(balance, delta))
 }
 
+// IncreaseBalances increases the balances of the validators by the given
+// deltas.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) IncreaseBalances(
+	deltas map[math.ValidatorIndex]math.Gwei,
+) error {
+	return s.applyBalanceDeltas(
+		deltas, func(balance, delta math.Gwei) math.Gwei {
+			return balance + delta
+		},
+	)
+}
+
+// DecreaseBalances decreases the balances of the validators by the given
+// deltas, without going below zero.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) DecreaseBalances(
+	deltas map[math.ValidatorIndex]math.Gwei,
+) error {
+	return s.applyBalanceDeltas(
+		deltas, func(balance, delta math.Gwei) math.Gwei {
+			return balance - min(balance, delta)
+		},
+	)
+}
+
+// applyBalanceDeltas sets the balance of each validator in deltas to the
+// result of apply. The balances are written in ascending index order, so the
+// writes are deterministic, and zero deltas are skipped.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) applyBalanceDeltas(
+	deltas map[math.ValidatorIndex]math.Gwei,
+	apply func(balance, delta math.Gwei) math.Gwei,
+) error {
+	for _, idx := range slices.Sorted(maps.Keys(deltas)) {
+		delta := deltas[idx]
+		if delta == 0 {
+			continue
+		}
+
+		balance, err := s.GetBalance(idx)
+		if err != nil {
+			return err
+		}
+		if err = s.SetBalance(idx, apply(balance, delta)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // UpdateSlashingAtIndex sets the slashing amount in the store.
 func (s *StateDB[
 	_, _, _, _, _, _, _, _, _, _,
@@ -183,13 +240,59 @@ func (s *StateDB[
 	return s.SetSlashingAtIndex(index, amount)
 }
 
+// ValidatorIndicesByWithdrawalCredentials returns the indices of the
+// validators with the given withdrawal credentials, in ascending order. The
+// lookup uses a secondary index of the store rather than scanning all the
+// validators.
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, WithdrawalCredentialsT,
+]) ValidatorIndicesByWithdrawalCredentials(
+	wc WithdrawalCredentialsT,
+) ([]math.ValidatorIndex, error) {
+	return s.KVStore.ValidatorIndicesByWithdrawalCredentials(
+		common.Bytes32(wc),
+	)
+}
+
+// GetValidatorChurnLimit as defined in the Ethereum 2.0 Specification:
+// https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#get_validator_churn_limit
+//
+//nolint:lll
+func (s *StateDB[
+	_, _, _, _, _, _, _, _, _, _,
+]) GetValidatorChurnLimit(epoch math.Epoch) (uint64, error) {
+	indices, err := s.GetActiveValidatorIndices(epoch)
+	if err != nil {
+		return 0, err
+	}
+
+	return max(
+		s.cs.MinPerEpochChurnLimit(),
+		uint64(len(indices))/s.cs.ChurnLimitQuotient(),
+	), nil
+}
+
 // ExpectedWithdrawals as defined in the Ethereum 2.0 Specification:
 // https://github.com/ethereum/consensus-specs/blob/dev/specs/capella/beacon-chain.md#new-get_expected_withdrawals
 //
 //nolint:lll
 func (s *StateDB[
-	_, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
+	_, _, _, _, _, _, _, _, WithdrawalT, _,
 ]) ExpectedWithdrawals() ([]WithdrawalT, error) {
+	slot, err := s.GetSlot()
+	if err != nil {
+		return nil, err
+	}
+	return s.ExpectedWithdrawalsAtSlot(slot)
+}
+
+// ExpectedWithdrawalsAtSlot returns the withdrawals expected if the state
+// were at the given slot. Only the withdrawable epoch depends on the slot, the
+// balances and the next withdrawal indices are read from the current state,
+// which is not modified.
+func (s *StateDB[
+	_, _, _, _, _, _, ValidatorT, _, WithdrawalT, _,
+]) ExpectedWithdrawalsAtSlot(slot math.Slot) ([]WithdrawalT, error) {
 	var (
 		validator         ValidatorT
 		balance           math.Gwei
@@ -197,12 +300,7 @@ func (s *StateDB[
 		withdrawals       = make([]WithdrawalT, 0)
 	)
 
-	slot, err := s.GetSlot()
-	if err != nil {
-		return nil, err
-	}
-
-	epoch := math.Epoch(slot.Unwrap() / s.cs.SlotsPerEpoch())
+	epoch := s.cs.EpochAtSlot(slot)
 
 	withdrawalIndex, err := s.GetNextWithdrawalIndex()
 	if err != nil {
@@ -261,6 +359,16 @@ func (s *StateDB[
 			amount,
 		)
 
+		// Only withdrawals that move funds need a valid recipient.
+		if amount > 0 {
+			if err = withdrawal.Validate(); err != nil {
+				return nil, errors.Wrapf(
+					err, "withdrawal %d of validator %d",
+					withdrawalIndex, validatorIndex,
+				)
+			}
+		}
+
 		withdrawals = append(withdrawals, withdrawal)
 
 		// Increment the withdrawal index to process the next withdrawal.
@@ -81,10 +81,13 @@ type Withdrawal[T any] interface {
 		address common.ExecutionAddress,
 		amount math.Gwei,
 	) T
+	// Validate returns an error if the withdrawal is malformed.
+	Validate() error
 }
 
 // WithdrawalCredentials represents an interface for withdrawal credentials.
 type WithdrawalCredentials interface {
+	~[32]byte
 	// ToExecutionAddress converts the withdrawal credentials to an execution
 	// address.
 	ToExecutionAddress() (common.ExecutionAddress, error)
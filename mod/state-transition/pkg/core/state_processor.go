@@ -22,6 +22,7 @@ package core
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
@@ -35,11 +36,11 @@ import (
 // main state transition for the beacon chain.
 type StateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, Eth1DataT, BeaconBlockBodyT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockHeaderT BeaconBlockHeader[BeaconBlockHeaderT],
@@ -50,10 +51,11 @@ type StateProcessor[
 		ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		VerifyAgainst(Eth1DataT) error
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -90,12 +92,12 @@ type StateProcessor[
 // NewStateProcessor creates a new state processor.
 func NewStateProcessor[
 	BeaconBlockT BeaconBlock[
-		DepositT, BeaconBlockBodyT,
+		DepositT, Eth1DataT, BeaconBlockBodyT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	BeaconBlockBodyT BeaconBlockBody[
 		BeaconBlockBodyT,
-		DepositT, ExecutionPayloadT,
+		DepositT, Eth1DataT, ExecutionPayloadT,
 		ExecutionPayloadHeaderT,
 		WithdrawalsT,
 	],
@@ -105,10 +107,11 @@ func NewStateProcessor[
 		KVStoreT, ValidatorT, ValidatorsT, WithdrawalT,
 	],
 	ContextT Context,
-	DepositT Deposit[ForkDataT, WithdrawalCredentialsT],
+	DepositT Deposit[WithdrawalCredentialsT],
 	Eth1DataT interface {
 		New(common.Root, math.U64, common.ExecutionHash) Eth1DataT
 		GetDepositCount() math.U64
+		VerifyAgainst(Eth1DataT) error
 	},
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
@@ -174,18 +177,92 @@ func (sp *StateProcessor[
 		return nil, err
 	}
 
+	// Abort before processing the block if we are out of time.
+	if err = checkProcessingDeadline(ctx); err != nil {
+		return nil, err
+	}
+
 	// Process the block.
 	if err = sp.ProcessBlock(ctx, st, blk); err != nil {
 		return nil, err
 	}
 
+	// A block processed past the deadline is rejected as well, so that the
+	// result does not depend on where the time ran out.
+	if err = checkProcessingDeadline(ctx); err != nil {
+		return nil, err
+	}
+
 	return validatorUpdates, nil
 }
 
+// TransitionCopy performs the state transition like Transition, on a copy of
+// the given state, and returns the resulting state. The given state is never
+// modified, even if the transition fails, so this can be used to evaluate a
+// block speculatively.
+func (sp *StateProcessor[
+	BeaconBlockT, _, _, BeaconStateT, ContextT,
+	_, _, _, _, _, _, _, _, _, _, _, _,
+]) TransitionCopy(
+	ctx ContextT,
+	st BeaconStateT,
+	blk BeaconBlockT,
+) (BeaconStateT, transition.ValidatorUpdates, error) {
+	var zero BeaconStateT
+	cpy := st.Copy()
+	validatorUpdates, err := sp.Transition(ctx, cpy, blk)
+	if err != nil {
+		return zero, nil, err
+	}
+	return cpy, validatorUpdates, nil
+}
+
+// checkProcessingDeadline returns ErrTransitionDeadlineExceeded if the
+// processing deadline of the context, if any, has passed.
+func checkProcessingDeadline(ctx Context) error {
+	deadline, ok := ctx.GetProcessingDeadline()
+	if !ok || time.Now().Before(deadline) {
+		return nil
+	}
+	return errors.Wrapf(
+		ErrTransitionDeadlineExceeded, "deadline %s", deadline,
+	)
+}
+
+// ProcessSlots advances the state to the given slot, processing the epoch
+// boundaries crossed on the way.
 func (sp *StateProcessor[
 	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
 ]) ProcessSlots(
 	st BeaconStateT, slot math.Slot,
+) (transition.ValidatorUpdates, error) {
+	return sp.processSlots(st, slot, nil)
+}
+
+// ProcessSlotsWithRoots advances the state to the given slot like
+// ProcessSlots, and also returns the state root after each slot crossed, in
+// order. The last root is the state root at the given slot.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) ProcessSlotsWithRoots(
+	st BeaconStateT, slot math.Slot,
+) ([]common.Root, transition.ValidatorUpdates, error) {
+	var roots []common.Root
+	validatorUpdates, err := sp.processSlots(st, slot, func() {
+		roots = append(roots, st.HashTreeRoot())
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return roots, validatorUpdates, nil
+}
+
+// processSlots advances the state to the given slot, calling onSlot, if not
+// nil, after each slot crossed.
+func (sp *StateProcessor[
+	_, _, _, BeaconStateT, _, _, _, _, _, _, _, _, _, _, _, _, _,
+]) processSlots(
+	st BeaconStateT, slot math.Slot, onSlot func(),
 ) (transition.ValidatorUpdates, error) {
 	var (
 		validatorUpdates      transition.ValidatorUpdates
@@ -205,8 +282,7 @@ func (sp *StateProcessor[
 		}
 
 		// Process the Epoch Boundary.
-		boundary := (stateSlot.Unwrap()+1)%sp.cs.SlotsPerEpoch() == 0
-		if boundary {
+		if sp.cs.SlotsUntilEpochBoundary(stateSlot) == 1 {
 			if epochValidatorUpdates, err =
 				sp.processEpoch(st); err != nil {
 				return nil, err
@@ -222,6 +298,10 @@ func (sp *StateProcessor[
 		if err = st.SetSlot(stateSlot + 1); err != nil {
 			return nil, err
 		}
+
+		if onSlot != nil {
+			onSlot()
+		}
 	}
 
 	return validatorUpdates, nil
@@ -486,23 +566,17 @@ func (sp *StateProcessor[
 		)
 	}
 
+	increases := make(map[math.ValidatorIndex]math.Gwei, len(rewards))
+	decreases := make(map[math.ValidatorIndex]math.Gwei, len(penalties))
 	for i := range validators {
-		// Increase the balance of the validator.
-		if err = st.IncreaseBalance(
-			math.ValidatorIndex(i),
-			rewards[i],
-		); err != nil {
-			return err
-		}
-
-		// Decrease the balance of the validator.
-		if err = st.DecreaseBalance(
-			math.ValidatorIndex(i),
-			penalties[i],
-		); err != nil {
-			return err
-		}
+		increases[math.ValidatorIndex(i)] = rewards[i]
+		decreases[math.ValidatorIndex(i)] = penalties[i]
 	}
 
-	return nil
+	// Apply all the rewards before the penalties, which for each validator
+	// is the same as applying its reward and then its penalty.
+	if err = st.IncreaseBalances(increases); err != nil {
+		return err
+	}
+	return st.DecreaseBalances(decreases)
 }
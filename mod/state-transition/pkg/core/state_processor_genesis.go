@@ -105,7 +105,7 @@ func (sp *StateProcessor[
 	}
 
 	// TODO: process activations.
-	validators, err := st.GetValidators()
+	validatorsRoot, err := st.GetValidatorsRoot()
 	if err != nil {
 		return nil, err
 	}
@@ -116,8 +116,7 @@ func (sp *StateProcessor[
 			common.Root(hex.MustToBytes(bArtioValRoot))); err != nil {
 			return nil, err
 		}
-	} else if err = st.
-		SetGenesisValidatorsRoot(validators.HashTreeRoot()); err != nil {
+	} else if err = st.SetGenesisValidatorsRoot(validatorsRoot); err != nil {
 		return nil, err
 	}
 
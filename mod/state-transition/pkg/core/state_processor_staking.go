@@ -47,6 +47,11 @@ func (sp *StateProcessor[
 	if err != nil {
 		return err
 	}
+
+	// The deposit count of the voted Eth1Data must never decrease.
+	if err = blk.GetBody().GetEth1Data().VerifyAgainst(eth1Data); err != nil {
+		return err
+	}
 	depositCount := min(
 		sp.cs.MaxDepositsPerBlock(),
 		eth1Data.GetDepositCount().Unwrap()-index,
@@ -158,14 +163,14 @@ func (sp *StateProcessor[
 	epoch = sp.cs.SlotToEpoch(slot)
 
 	// Verify that the message was signed correctly.
-	var d ForkDataT
+	var fd ForkDataT
+	fd = fd.New(
+		version.FromUint32[common.Version](
+			sp.cs.ActiveForkVersionForEpoch(epoch),
+		), genesisValidatorsRoot,
+	)
 	if err = dep.VerifySignature(
-		d.New(
-			version.FromUint32[common.Version](
-				sp.cs.ActiveForkVersionForEpoch(epoch),
-			), genesisValidatorsRoot,
-		),
-		sp.cs.DomainTypeDeposit(),
+		fd.ComputeDomain(sp.cs.DomainTypeDeposit()),
 		sp.signer.VerifySignature,
 	); err != nil {
 		return err
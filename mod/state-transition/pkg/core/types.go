@@ -23,6 +23,7 @@ package core
 import (
 	stdbytes "bytes"
 	"context"
+	"time"
 
 	engineprimitives "github.com/berachain/beacon-kit/mod/engine-primitives/pkg/engine-primitives"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
@@ -36,8 +37,9 @@ import (
 // BeaconBlock represents a generic interface for a beacon block.
 type BeaconBlock[
 	DepositT any,
+	Eth1DataT any,
 	BeaconBlockBodyT BeaconBlockBody[
-		BeaconBlockBodyT, DepositT,
+		BeaconBlockBodyT, DepositT, Eth1DataT,
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
 	ExecutionPayloadT ExecutionPayload[
@@ -64,6 +66,7 @@ type BeaconBlock[
 type BeaconBlockBody[
 	BeaconBlockBodyT any,
 	DepositT any,
+	Eth1DataT any,
 	ExecutionPayloadT ExecutionPayload[
 		ExecutionPayloadT, ExecutionPayloadHeaderT, WithdrawalsT,
 	],
@@ -77,6 +80,8 @@ type BeaconBlockBody[
 	GetExecutionPayload() ExecutionPayloadT
 	// GetDeposits returns the list of deposits.
 	GetDeposits() []DepositT
+	// GetEth1Data returns the Eth1Data voted for by the block.
+	GetEth1Data() Eth1DataT
 	// HashTreeRoot returns the hash tree root of the block body.
 	HashTreeRoot() common.Root
 	// GetBlobKzgCommitments returns the KZG commitments for the blobs.
@@ -118,23 +123,23 @@ type Context interface {
 	// GetSkipValidateResult returns whether to validate the result of the state
 	// transition.
 	GetSkipValidateResult() bool
+	// GetProcessingDeadline returns the time by which the state transition
+	// must be processed, and whether a deadline is set.
+	GetProcessingDeadline() (time.Time, bool)
 }
 
 // Deposit is the interface for a deposit.
-type Deposit[
-	ForkDataT any,
-	WithdrawlCredentialsT ~[32]byte,
-] interface {
+type Deposit[WithdrawlCredentialsT ~[32]byte] interface {
 	// GetAmount returns the amount of the deposit.
 	GetAmount() math.Gwei
 	// GetPubkey returns the public key of the validator.
 	GetPubkey() crypto.BLSPubkey
 	// GetWithdrawalCredentials returns the withdrawal credentials.
 	GetWithdrawalCredentials() WithdrawlCredentialsT
-	// VerifySignature verifies the deposit and creates a validator.
+	// VerifySignature verifies the deposit signature over the given signing
+	// domain.
 	VerifySignature(
-		forkData ForkDataT,
-		domainType common.DomainType,
+		domain common.Domain,
 		signatureVerificationFn func(
 			pubkey crypto.BLSPubkey,
 			message []byte, signature crypto.BLSSignature,
@@ -195,6 +200,8 @@ type ExecutionEngine[
 type ForkData[ForkDataT any] interface {
 	// New creates a new fork data object.
 	New(common.Version, common.Root) ForkDataT
+	// ComputeDomain returns the signing domain for the given domain type.
+	ComputeDomain(domainType common.DomainType) common.Domain
 	// ComputeRandaoSigningRoot returns the signing root for the fork data.
 	ComputeRandaoSigningRoot(
 		domainType common.DomainType,
@@ -218,6 +225,11 @@ type Validator[
 		effectiveBalanceIncrement math.Gwei,
 		maxEffectiveBalance math.Gwei,
 	) ValidatorT
+	// IsActive returns true if the validator is active at the given epoch.
+	IsActive(epoch math.Epoch) bool
+	// IsEligibleForActivation returns true if the validator can be activated
+	// once the given epoch is finalized.
+	IsEligibleForActivation(finalizedEpoch math.Epoch) bool
 	// IsSlashed returns true if the validator is slashed.
 	IsSlashed() bool
 	// GetPubkey returns the public key of the validator.
@@ -247,4 +259,6 @@ type Withdrawal[WithdrawalT any] interface {
 	GetValidatorIndex() math.ValidatorIndex
 	// GetAddress returns the address of the withdrawal.
 	GetAddress() common.ExecutionAddress
+	// Validate returns an error if the withdrawal is malformed.
+	Validate() error
 }
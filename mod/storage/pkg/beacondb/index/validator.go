@@ -35,6 +35,7 @@ const (
 	validatorPubkeyToIndexPrefix           = "val_pk_to_idx"
 	validatorConsAddrToIndexPrefix         = "val_cons_addr_to_idx"
 	validatorEffectiveBalanceToIndexPrefix = "val_eff_bal_to_idx"
+	validatorWithdrawalCredsToIndexPrefix  = "val_wc_to_idx"
 )
 
 // Validator is an interface that combines the ssz.Marshaler and
@@ -59,6 +60,9 @@ type ValidatorsIndex[ValidatorT Validator] struct {
 	// CometBFTAddress is a unique index mapping a validator's Comet BFT address
 	// to their numeric ID.
 	CometBFTAddress *indexes.Unique[[]byte, uint64, ValidatorT]
+	// WithdrawalCredentials is a multi-index mapping a validator's withdrawal
+	// credentials to their numeric ID.
+	WithdrawalCredentials *indexes.Multi[[]byte, uint64, ValidatorT]
 }
 
 // IndexesList returns a list of all indexes associated with the
@@ -70,12 +74,25 @@ func (a ValidatorsIndex[ValidatorT]) IndexesList() []sdkcollections.Index[
 		a.Pubkey,
 		a.EffectiveBalance,
 		a.CometBFTAddress,
+		a.WithdrawalCredentials,
 	}
 }
 
+// CometBFTAddress returns the CometBFT address the CometBFTAddress index
+// stores for a validator with the given public key.
+func CometBFTAddress(pubkey crypto.BLSPubkey) []byte {
+	return cmtcrypto.AddressHash(pubkey[:]).Bytes()
+}
+
 // NewValidatorsIndex creates a new validatorsIndex with a unique index for
 // validator public keys.
-func NewValidatorsIndex[ValidatorT Validator](
+func NewValidatorsIndex[
+	ValidatorT interface {
+		Validator
+		GetWithdrawalCredentials() WithdrawalCredentialsT
+	},
+	WithdrawalCredentialsT ~[32]byte,
+](
 	sb *sdkcollections.SchemaBuilder,
 ) ValidatorsIndex[ValidatorT] {
 	return ValidatorsIndex[ValidatorT]{
@@ -107,8 +124,18 @@ func NewValidatorsIndex[ValidatorT Validator](
 			sdkcollections.BytesKey,
 			sdkcollections.Uint64Key,
 			func(_ uint64, validator ValidatorT) ([]byte, error) {
-				pk := validator.GetPubkey()
-				return cmtcrypto.AddressHash(pk[:]).Bytes(), nil
+				return CometBFTAddress(validator.GetPubkey()), nil
+			},
+		),
+		WithdrawalCredentials: indexes.NewMulti(
+			sb,
+			sdkcollections.NewPrefix(validatorWithdrawalCredsToIndexPrefix),
+			validatorWithdrawalCredsToIndexPrefix,
+			sdkcollections.BytesKey,
+			sdkcollections.Uint64Key,
+			func(_ uint64, validator ValidatorT) ([]byte, error) {
+				wc := validator.GetWithdrawalCredentials()
+				return wc[:], nil
 			},
 		),
 	}
@@ -25,6 +25,7 @@ import (
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/keys"
@@ -53,7 +54,7 @@ type KVStore[
 		constraints.SSZMarshallable
 	},
 	ValidatorT Validator[ValidatorT],
-	ValidatorsT ~[]ValidatorT,
+	ValidatorsT Validators[ValidatorT],
 ] struct {
 	ctx context.Context
 	// Versioning
@@ -91,6 +92,9 @@ type KVStore[
 	validators *sdkcollections.IndexedMap[
 		uint64, ValidatorT, index.ValidatorsIndex[ValidatorT],
 	]
+	// validatorsRoot caches the hash tree root of the validators. It is
+	// reset whenever the registry is modified through this store.
+	validatorsRoot *common.Root
 	// balances stores the list of balances.
 	balances sdkcollections.Map[uint64, uint64]
 	// nextWithdrawalIndex stores the next global withdrawal index.
@@ -129,8 +133,12 @@ func New[
 		constraints.Empty[ForkT]
 		constraints.SSZMarshallable
 	},
-	ValidatorT Validator[ValidatorT],
-	ValidatorsT ~[]ValidatorT,
+	ValidatorT interface {
+		Validator[ValidatorT]
+		GetWithdrawalCredentials() WithdrawalCredentialsT
+	},
+	ValidatorsT Validators[ValidatorT],
+	WithdrawalCredentialsT ~[32]byte,
 ](
 	kss store.KVStoreService,
 	payloadCodec *encoding.SSZInterfaceCodec[ExecutionPayloadHeaderT],
@@ -216,7 +224,9 @@ func New[
 			keys.ValidatorByIndexPrefixHumanReadable,
 			sdkcollections.Uint64Key,
 			encoding.SSZValueCodec[ValidatorT]{},
-			index.NewValidatorsIndex[ValidatorT](schemaBuilder),
+			index.NewValidatorsIndex[ValidatorT, WithdrawalCredentialsT](
+				schemaBuilder,
+			),
 		),
 		balances: sdkcollections.NewMap(
 			schemaBuilder,
@@ -281,6 +291,8 @@ func (kv *KVStore[
 	// TODO: Decouple the KVStore type from the Cosmos-SDK.
 	cctx, _ := sdk.UnwrapSDKContext(kv.ctx).CacheContext()
 	ss := kv.WithContext(cctx)
+	// The branched context starts from the same registry.
+	ss.validatorsRoot = kv.validatorsRoot
 	return ss
 }
 
@@ -304,5 +316,6 @@ func (kv *KVStore[
 ] {
 	cpy := *kv
 	cpy.ctx = ctx
+	cpy.validatorsRoot = nil
 	return &cpy
 }
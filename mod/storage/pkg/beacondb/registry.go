@@ -24,8 +24,10 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections/indexes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb/index"
 )
 
 // AddValidator registers a new validator in the beacon state.
@@ -33,6 +35,8 @@ func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ]) AddValidator(val ValidatorT) error {
+	kv.validatorsRoot = nil
+
 	// Get the next validator index from the sequence.
 	idx, err := kv.validatorIndex.Next(kv.ctx)
 	if err != nil {
@@ -52,6 +56,8 @@ func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
 	ForkT, ValidatorT, ValidatorsT,
 ]) AddValidatorBartio(val ValidatorT) error {
+	kv.validatorsRoot = nil
+
 	// Get the ne
 	idx, err := kv.validatorIndex.Next(kv.ctx)
 	if err != nil {
@@ -75,6 +81,7 @@ func (kv *KVStore[
 	index math.ValidatorIndex,
 	val ValidatorT,
 ) error {
+	kv.validatorsRoot = nil
 	return kv.validators.Set(kv.ctx, index.Unwrap(), val)
 }
 
@@ -112,6 +119,21 @@ func (kv *KVStore[
 	return math.ValidatorIndex(idx), nil
 }
 
+// CometBFTAddressByValidatorIndex returns the CometBFT address of the
+// validator at the given index, as keyed in the CometBFT address index.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) CometBFTAddressByValidatorIndex(
+	idx math.ValidatorIndex,
+) ([]byte, error) {
+	val, err := kv.validators.Get(kv.ctx, idx.Unwrap())
+	if err != nil {
+		return nil, err
+	}
+	return index.CometBFTAddress(val.GetPubkey()), nil
+}
+
 // ValidatorByIndex returns the validator address by index.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
@@ -163,6 +185,83 @@ func (kv *KVStore[
 	return vals, err
 }
 
+// GetValidatorsRoot returns the hash tree root of all validators. The root is
+// cached until the registry is next modified through this store.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetValidatorsRoot() (common.Root, error) {
+	if kv.validatorsRoot != nil {
+		return *kv.validatorsRoot, nil
+	}
+
+	vals, err := kv.GetValidators()
+	if err != nil {
+		return common.Root{}, err
+	}
+	root := vals.HashTreeRoot()
+	kv.validatorsRoot = &root
+	return root, nil
+}
+
+// ValidatorIndicesByWithdrawalCredentials returns the indices of the
+// validators with the given withdrawal credentials, in ascending order.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) ValidatorIndicesByWithdrawalCredentials(
+	wc common.Bytes32,
+) ([]math.ValidatorIndex, error) {
+	iter, err := kv.validators.Indexes.WithdrawalCredentials.MatchExact(
+		kv.ctx, wc[:],
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// PrimaryKeys consumes and closes the iterator.
+	idxs, err := iter.PrimaryKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	indices := make([]math.ValidatorIndex, len(idxs))
+	for i, idx := range idxs {
+		indices[i] = math.ValidatorIndex(idx)
+	}
+	return indices, nil
+}
+
+// GetActiveValidatorIndices returns the indices of the validators active at
+// the given epoch, in ascending order.
+func (kv *KVStore[
+	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
+	ForkT, ValidatorT, ValidatorsT,
+]) GetActiveValidatorIndices(
+	epoch math.Epoch,
+) ([]math.ValidatorIndex, error) {
+	var indices []math.ValidatorIndex
+
+	iter, err := kv.validators.Iterate(kv.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = errors.Join(err, iter.Close())
+	}()
+
+	for ; iter.Valid(); iter.Next() {
+		entry, kvErr := iter.KeyValue()
+		if kvErr != nil {
+			return nil, kvErr
+		}
+		if entry.Value.IsActive(epoch) {
+			indices = append(indices, math.ValidatorIndex(entry.Key))
+		}
+	}
+	return indices, err
+}
+
 // GetTotalValidators returns the total number of validators.
 func (kv *KVStore[
 	BeaconBlockHeaderT, Eth1DataT, ExecutionPayloadHeaderT,
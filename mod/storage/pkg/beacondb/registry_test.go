@@ -33,6 +33,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
 	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/bytes"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/beacondb"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
@@ -197,6 +198,200 @@ func TestValidators(t *testing.T) {
 	require.Equal(t, inUpdatedVal2, res[1])
 }
 
+func TestGetActiveValidatorIndices(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	// no validators to start
+	res, err := store.GetActiveValidatorIndices(0)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// add validators active over different epochs
+	vals := []*types.Validator{
+		{Pubkey: bytes.B48{0x01}, ActivationEpoch: 0, ExitEpoch: 10},
+		{Pubkey: bytes.B48{0x02}, ActivationEpoch: 5, ExitEpoch: 20},
+		{Pubkey: bytes.B48{0x03}, ActivationEpoch: 0, ExitEpoch: 5},
+	}
+	for _, val := range vals {
+		require.NoError(t, store.AddValidator(val))
+	}
+
+	tests := []struct {
+		name     string
+		epoch    math.Epoch
+		expected []math.ValidatorIndex
+	}{
+		{
+			name:     "before any exit",
+			epoch:    0,
+			expected: []math.ValidatorIndex{0, 2},
+		},
+		{
+			name:     "activation and exit epoch",
+			epoch:    5,
+			expected: []math.ValidatorIndex{0, 1},
+		},
+		{
+			name:     "after all exits",
+			epoch:    20,
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indices, idxErr := store.GetActiveValidatorIndices(tt.epoch)
+			require.NoError(t, idxErr)
+			require.Equal(t, tt.expected, indices)
+		})
+	}
+}
+
+func TestValidatorIndicesByWithdrawalCredentials(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	var (
+		wc1 = types.WithdrawalCredentials{0x01}
+		wc2 = types.WithdrawalCredentials{0x02}
+	)
+
+	// no validators to start
+	res, err := store.ValidatorIndicesByWithdrawalCredentials(
+		common.Bytes32(wc1),
+	)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	// add validators, two of which share withdrawal credentials
+	vals := []*types.Validator{
+		{Pubkey: bytes.B48{0x01}, WithdrawalCredentials: wc1},
+		{Pubkey: bytes.B48{0x02}, WithdrawalCredentials: wc2},
+		{Pubkey: bytes.B48{0x03}, WithdrawalCredentials: wc1},
+	}
+	for _, val := range vals {
+		require.NoError(t, store.AddValidator(val))
+	}
+
+	res, err = store.ValidatorIndicesByWithdrawalCredentials(
+		common.Bytes32(wc1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0, 2}, res)
+
+	// updating the withdrawal credentials moves the validator in the index
+	require.NoError(t, store.UpdateValidatorAtIndex(2, &types.Validator{
+		Pubkey:                vals[2].Pubkey,
+		WithdrawalCredentials: wc2,
+	}))
+
+	res, err = store.ValidatorIndicesByWithdrawalCredentials(
+		common.Bytes32(wc1),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{0}, res)
+
+	res, err = store.ValidatorIndicesByWithdrawalCredentials(
+		common.Bytes32(wc2),
+	)
+	require.NoError(t, err)
+	require.Equal(t, []math.ValidatorIndex{1, 2}, res)
+}
+
+func TestCometBFTAddressByValidatorIndex(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	_, err = store.CometBFTAddressByValidatorIndex(0)
+	require.Error(t, err)
+
+	for _, pk := range []bytes.B48{{0x01}, {0x02}} {
+		require.NoError(t, store.AddValidator(
+			&types.Validator{Pubkey: pk, EffectiveBalance: 32e9},
+		))
+	}
+
+	// the reverse lookup must round trip through the forward index
+	for _, idx := range []math.ValidatorIndex{0, 1} {
+		addr, addrErr := store.CometBFTAddressByValidatorIndex(idx)
+		require.NoError(t, addrErr)
+		got, idxErr := store.ValidatorIndexByCometBFTAddress(addr)
+		require.NoError(t, idxErr)
+		require.Equal(t, idx, got)
+	}
+}
+
+func TestGetValidatorsRoot(t *testing.T) {
+	store, err := initTestStore()
+	require.NoError(t, err)
+
+	requireRoot := func(st interface {
+		GetValidators() (types.Validators, error)
+		GetValidatorsRoot() (common.Root, error)
+	}) {
+		vals, valsErr := st.GetValidators()
+		require.NoError(t, valsErr)
+		root, rootErr := st.GetValidatorsRoot()
+		require.NoError(t, rootErr)
+		require.Equal(t, vals.HashTreeRoot(), root)
+	}
+
+	// empty registry
+	requireRoot(store)
+
+	// adding a validator invalidates the cached root
+	val := &types.Validator{Pubkey: bytes.B48{0x01}, EffectiveBalance: 32e9}
+	require.NoError(t, store.AddValidator(val))
+	requireRoot(store)
+
+	require.NoError(t, store.AddValidatorBartio(
+		&types.Validator{Pubkey: bytes.B48{0x02}, EffectiveBalance: 32e9},
+	))
+	requireRoot(store)
+
+	// updating a validator invalidates the cached root
+	val.EffectiveBalance = 16e9
+	require.NoError(t, store.UpdateValidatorAtIndex(0, val))
+	requireRoot(store)
+
+	// a store with a new context does not reuse the cached root
+	other := store.WithContext(context.Background())
+	require.NoError(t, other.UpdateValidatorAtIndex(
+		0, &types.Validator{Pubkey: bytes.B48{0x01}, EffectiveBalance: 8e9},
+	))
+	requireRoot(store.WithContext(context.Background()))
+}
+
+func BenchmarkGetValidatorsRoot(b *testing.B) {
+	const numValidators = 100_000
+	store, err := initTestStore()
+	require.NoError(b, err)
+	for i := range numValidators {
+		require.NoError(b, store.AddValidator(&types.Validator{
+			Pubkey:           bytes.B48{byte(i), byte(i >> 8), byte(i >> 16)},
+			EffectiveBalance: 32e9,
+		}))
+	}
+
+	b.Run("uncached", func(b *testing.B) {
+		for range b.N {
+			vals, valsErr := store.GetValidators()
+			require.NoError(b, valsErr)
+			_ = vals.HashTreeRoot()
+		}
+	})
+
+	b.Run("cached", func(b *testing.B) {
+		_, err = store.GetValidatorsRoot()
+		require.NoError(b, err)
+		b.ResetTimer()
+		for range b.N {
+			_, err = store.GetValidatorsRoot()
+			require.NoError(b, err)
+		}
+	})
+}
+
 func initTestStore() (
 	*beacondb.KVStore[
 		*types.BeaconBlockHeader,
@@ -204,7 +399,7 @@ func initTestStore() (
 		*types.ExecutionPayloadHeader,
 		*types.Fork,
 		*types.Validator,
-		[]*types.Validator,
+		types.Validators,
 	], error) {
 	db, err := db.OpenDB("", dbm.MemDBBackend)
 	if err != nil {
@@ -236,7 +431,8 @@ func initTestStore() (
 		*types.ExecutionPayloadHeader,
 		*types.Fork,
 		*types.Validator,
-		[]*types.Validator,
+		types.Validators,
+		types.WithdrawalCredentials,
 	](
 		testStoreService,
 		testCodec,
@@ -21,6 +21,7 @@
 package beacondb
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
@@ -38,3 +39,10 @@ type Validator[SelfT any] interface {
 	// IsActive checks if the validator is active at the given epoch.
 	IsActive(epoch math.Epoch) bool
 }
+
+// Validators represents an interface for the list of validators.
+type Validators[ValidatorT any] interface {
+	~[]ValidatorT
+	// HashTreeRoot returns the hash tree root of the validators.
+	HashTreeRoot() common.Root
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import "github.com/berachain/beacon-kit/mod/errors"
+
+var (
+	// ErrNoBlocks is returned when the store does not hold any blocks.
+	ErrNoBlocks = errors.New("no blocks in the store")
+
+	// ErrBlockNotFound is returned when the store does not hold the requested
+	// block.
+	ErrBlockNotFound = errors.New("block not found")
+
+	// ErrInconsistentIndex is returned when an index of the store does not
+	// agree with the stored blocks.
+	ErrInconsistentIndex = errors.New("inconsistent block store index")
+
+	// ErrNoBlockBelow is returned when the store does not hold any block at
+	// or below a given execution number.
+	ErrNoBlockBelow = errors.New("no block at or below execution number")
+)
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package block
+
+import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
+)
+
+// ReadOnlyStore is a read-only view of a block store, for readers such as
+// the node API that must not write to it. Its lookups never update the
+// recency of the cached entries and thus only take the read locks of the
+// underlying store.
+type ReadOnlyStore[BeaconBlockT BeaconBlock] struct {
+	kv *KVStore[BeaconBlockT]
+}
+
+// NewReadOnlyStore creates a read-only view of the given block store.
+func NewReadOnlyStore[BeaconBlockT BeaconBlock](
+	kv *KVStore[BeaconBlockT],
+) *ReadOnlyStore[BeaconBlockT] {
+	return &ReadOnlyStore[BeaconBlockT]{kv: kv}
+}
+
+// GetSlotByBlockRoot retrieves the slot by a given block root from the store.
+func (s *ReadOnlyStore[BeaconBlockT]) GetSlotByBlockRoot(
+	blockRoot common.Root,
+) (math.Slot, error) {
+	return s.kv.GetSlotByBlockRoot(blockRoot)
+}
+
+// GetSlotsByBlockRoots retrieves the slots by the given block roots from the
+// store.
+func (s *ReadOnlyStore[BeaconBlockT]) GetSlotsByBlockRoots(
+	blockRoots []common.Root,
+) (map[common.Root]math.Slot, error) {
+	return s.kv.GetSlotsByBlockRoots(blockRoots)
+}
+
+// GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
+// the store.
+func (s *ReadOnlyStore[BeaconBlockT]) GetParentSlotByTimestamp(
+	timestamp math.U64,
+) (math.Slot, error) {
+	return s.kv.GetParentSlotByTimestamp(timestamp)
+}
+
+// GetSlotByStateRoot retrieves the slot by a given state root from the store.
+func (s *ReadOnlyStore[BeaconBlockT]) GetSlotByStateRoot(
+	stateRoot common.Root,
+) (math.Slot, error) {
+	return s.kv.GetSlotByStateRoot(stateRoot)
+}
+
+// GetSlotByExecutionNumber retrieves the slot by a given execution number from
+// the store.
+func (s *ReadOnlyStore[BeaconBlockT]) GetSlotByExecutionNumber(
+	executionNumber math.U64,
+) (math.Slot, error) {
+	return s.kv.GetSlotByExecutionNumber(executionNumber)
+}
@@ -22,6 +22,7 @@ package block
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
@@ -33,8 +34,13 @@ import (
 // KVStore is a simple memory store based implementation that stores metadata of
 // beacon blocks.
 type KVStore[BeaconBlockT BeaconBlock] struct {
-	// Beacon block root to slot mapping is injective for finalized blocks.
-	blockRoots *lru.Cache[common.Root, math.Slot]
+	// Slot to beacon block mapping for the blocks in the availability window.
+	blocks *lru.Cache[math.Slot, BeaconBlockT]
+
+	// Beacon block root to beacon block mapping is injective for finalized
+	// blocks. It indexes the blocks themselves so that a block is retrieved by
+	// its root with a single read.
+	blockRoots *lru.Cache[common.Root, BeaconBlockT]
 
 	// Timestamp to slot mapping is injective for finalized blocks. This is
 	// guaranteed by CometBFT consensus. So each slot will be associated with a
@@ -44,6 +50,11 @@ type KVStore[BeaconBlockT BeaconBlock] struct {
 	// Beacon state root to slot mapping is injective for finalized blocks.
 	stateRoots *lru.Cache[common.Root, math.Slot]
 
+	// Execution number to beacon block mapping is injective for finalized
+	// blocks. It indexes the blocks themselves so that a block is retrieved by
+	// its execution number with a single read.
+	executionNumbers *lru.Cache[math.U64, BeaconBlockT]
+
 	// Logger for the store.
 	logger log.Logger
 }
@@ -53,7 +64,11 @@ func NewStore[BeaconBlockT BeaconBlock](
 	logger log.Logger,
 	availabilityWindow int,
 ) *KVStore[BeaconBlockT] {
-	blockRoots, err := lru.New[common.Root, math.Slot](availabilityWindow)
+	blocks, err := lru.New[math.Slot, BeaconBlockT](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
+	blockRoots, err := lru.New[common.Root, BeaconBlockT](availabilityWindow)
 	if err != nil {
 		panic(err)
 	}
@@ -65,34 +80,190 @@ func NewStore[BeaconBlockT BeaconBlock](
 	if err != nil {
 		panic(err)
 	}
+	executionNumbers, err := lru.New[math.U64, BeaconBlockT](availabilityWindow)
+	if err != nil {
+		panic(err)
+	}
 	return &KVStore[BeaconBlockT]{
-		blockRoots: blockRoots,
-		timestamps: timestamps,
-		stateRoots: stateRoots,
-		logger:     logger,
+		blocks:           blocks,
+		blockRoots:       blockRoots,
+		timestamps:       timestamps,
+		stateRoots:       stateRoots,
+		executionNumbers: executionNumbers,
+		logger:           logger,
 	}
 }
 
 // Set sets the block by a given index in the store, storing the block root,
-// timestamp, and state root. Only this function may potentially evict
+// timestamp, state root, and execution number. Only this function may potentially evict
 // entries from the store if the availability window is reached.
 func (kv *KVStore[BeaconBlockT]) Set(blk BeaconBlockT) error {
 	slot := blk.GetSlot()
-	kv.blockRoots.Add(blk.HashTreeRoot(), slot)
+	kv.blocks.Add(slot, blk)
+	kv.blockRoots.Add(blk.HashTreeRoot(), blk)
 	kv.timestamps.Add(blk.GetTimestamp(), slot)
 	kv.stateRoots.Add(blk.GetStateRoot(), slot)
+	kv.executionNumbers.Add(blk.GetExecutionNumber(), blk)
+	return nil
+}
+
+// Prune removes the blocks in the slot range [start, end) from the store,
+// along with their entries in the slot indexes.
+func (kv *KVStore[BeaconBlockT]) Prune(start, end uint64) error {
+	for _, slot := range kv.blocks.Keys() {
+		if slot.Unwrap() < start || slot.Unwrap() >= end {
+			continue
+		}
+		blk, ok := kv.blocks.Peek(slot)
+		if !ok {
+			continue
+		}
+		kv.blockRoots.Remove(blk.HashTreeRoot())
+		kv.timestamps.Remove(blk.GetTimestamp())
+		kv.stateRoots.Remove(blk.GetStateRoot())
+		kv.executionNumbers.Remove(blk.GetExecutionNumber())
+		kv.blocks.Remove(slot)
+	}
 	return nil
 }
 
+// PruneBefore removes the blocks whose execution payload timestamp is before
+// the given timestamp. The slot boundary is the lowest slot holding a block at
+// or after the timestamp and all blocks below it are pruned. If no block
+// predates the timestamp, this is a no-op. If every block predates it, the
+// store is emptied.
+func (kv *KVStore[BeaconBlockT]) PruneBefore(timestamp uint64) error {
+	var (
+		boundary math.Slot
+		found    bool
+	)
+	for _, slot := range kv.blocks.Keys() {
+		blk, ok := kv.blocks.Peek(slot)
+		if !ok || blk.GetTimestamp().Unwrap() < timestamp {
+			continue
+		}
+		if !found || slot < boundary {
+			boundary, found = slot, true
+		}
+	}
+	if !found {
+		_, latest, err := kv.GetLatest()
+		if errors.Is(err, ErrNoBlocks) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		boundary = latest + 1
+	}
+	return kv.Prune(0, boundary.Unwrap())
+}
+
+// GetRange retrieves the blocks in the slot range [start, end), ordered by
+// slot. Slots without a block in the store are skipped.
+func (kv *KVStore[BeaconBlockT]) GetRange(
+	start, end math.Slot,
+) ([]BeaconBlockT, error) {
+	if kv.blocks.Len() == 0 {
+		return nil, ErrNoBlocks
+	}
+
+	// Iterate the cached slots rather than the range, which may be far larger
+	// than the availability window.
+	slots := make([]math.Slot, 0, kv.blocks.Len())
+	for _, slot := range kv.blocks.Keys() {
+		if slot >= start && slot < end {
+			slots = append(slots, slot)
+		}
+	}
+	slices.Sort(slots)
+
+	blks := make([]BeaconBlockT, 0, len(slots))
+	for _, slot := range slots {
+		if blk, ok := kv.blocks.Peek(slot); ok {
+			blks = append(blks, blk)
+		}
+	}
+	return blks, nil
+}
+
+// GetLatest retrieves the block with the highest slot from the store, along
+// with its slot.
+func (kv *KVStore[BeaconBlockT]) GetLatest() (BeaconBlockT, math.Slot, error) {
+	var (
+		latest BeaconBlockT
+		slot   math.Slot
+		found  bool
+	)
+	for _, s := range kv.blocks.Keys() {
+		if blk, ok := kv.blocks.Peek(s); ok && (!found || s > slot) {
+			latest, slot, found = blk, s, true
+		}
+	}
+	if !found {
+		return latest, 0, ErrNoBlocks
+	}
+	return latest, slot, nil
+}
+
+// GetOldest retrieves the block with the lowest slot from the store, along
+// with its slot.
+func (kv *KVStore[BeaconBlockT]) GetOldest() (BeaconBlockT, math.Slot, error) {
+	var (
+		oldest BeaconBlockT
+		slot   math.Slot
+		found  bool
+	)
+	for _, s := range kv.blocks.Keys() {
+		if blk, ok := kv.blocks.Peek(s); ok && (!found || s < slot) {
+			oldest, slot, found = blk, s, true
+		}
+	}
+	if !found {
+		return oldest, 0, ErrNoBlocks
+	}
+	return oldest, slot, nil
+}
+
 // GetSlotByRoot retrieves the slot by a given block root from the store.
 func (kv *KVStore[BeaconBlockT]) GetSlotByBlockRoot(
 	blockRoot common.Root,
 ) (math.Slot, error) {
-	slot, ok := kv.blockRoots.Peek(blockRoot)
+	blk, ok := kv.blockRoots.Peek(blockRoot)
 	if !ok {
 		return 0, fmt.Errorf("slot not found at block root: %s", blockRoot)
 	}
-	return slot, nil
+	return blk.GetSlot(), nil
+}
+
+// GetByRoot retrieves the block by a given block root from the store.
+func (kv *KVStore[BeaconBlockT]) GetByRoot(
+	blockRoot common.Root,
+) (BeaconBlockT, error) {
+	blk, ok := kv.blockRoots.Peek(blockRoot)
+	if !ok {
+		return blk, errors.Wrapf(
+			ErrBlockNotFound, "block root %s", blockRoot,
+		)
+	}
+	return blk, nil
+}
+
+// GetSlotsByBlockRoots retrieves the slots by the given block roots from the
+// store. It errors if any of the block roots is not found.
+func (kv *KVStore[BeaconBlockT]) GetSlotsByBlockRoots(
+	blockRoots []common.Root,
+) (map[common.Root]math.Slot, error) {
+	slots := make(map[common.Root]math.Slot, len(blockRoots))
+	for _, blockRoot := range blockRoots {
+		blk, ok := kv.blockRoots.Peek(blockRoot)
+		if !ok {
+			return nil, fmt.Errorf(
+				"slot not found at block root: %s", blockRoot,
+			)
+		}
+		slots[blockRoot] = blk.GetSlot()
+	}
+	return slots, nil
 }
 
 // GetParentSlotByTimestamp retrieves the parent slot by a given timestamp from
@@ -121,3 +292,128 @@ func (kv *KVStore[BeaconBlockT]) GetSlotByStateRoot(
 	}
 	return slot, nil
 }
+
+// GetSlotByExecutionNumber retrieves the slot by a given execution number from
+// the store.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumber(
+	executionNumber math.U64,
+) (math.Slot, error) {
+	blk, ok := kv.executionNumbers.Peek(executionNumber)
+	if !ok {
+		return 0, fmt.Errorf(
+			"slot not found at execution number: %d", executionNumber,
+		)
+	}
+	return blk.GetSlot(), nil
+}
+
+// GetByExecutionNumber retrieves the block wrapping the execution payload of
+// the given execution number from the store.
+func (kv *KVStore[BeaconBlockT]) GetByExecutionNumber(
+	executionNumber math.U64,
+) (BeaconBlockT, error) {
+	blk, ok := kv.executionNumbers.Peek(executionNumber)
+	if !ok {
+		return blk, errors.Wrapf(
+			ErrBlockNotFound, "execution number %d", executionNumber,
+		)
+	}
+	return blk, nil
+}
+
+// GetSlotByExecutionNumberOrBelow retrieves the slot of the block with the
+// highest execution number at or below the given execution number.
+func (kv *KVStore[BeaconBlockT]) GetSlotByExecutionNumberOrBelow(
+	executionNumber math.U64,
+) (math.Slot, error) {
+	if blk, ok := kv.executionNumbers.Peek(executionNumber); ok {
+		return blk.GetSlot(), nil
+	}
+
+	// Seek backwards over the indexed execution numbers for the closest one
+	// below the requested number.
+	numbers := kv.executionNumbers.Keys()
+	slices.Sort(numbers)
+	i, _ := slices.BinarySearch(numbers, executionNumber)
+	for ; i > 0; i-- {
+		if blk, ok := kv.executionNumbers.Peek(numbers[i-1]); ok {
+			return blk.GetSlot(), nil
+		}
+	}
+	return 0, errors.Wrapf(
+		ErrNoBlockBelow, "execution number %d", executionNumber,
+	)
+}
+
+// Verify checks that the forward slot index and the reverse indexes of the
+// store agree for every entry. It returns the first inconsistency found, with
+// its slot and block root, walking the blocks by increasing slot.
+func (kv *KVStore[BeaconBlockT]) Verify() error {
+	slots := kv.blocks.Keys()
+	slices.Sort(slots)
+	for _, slot := range slots {
+		blk, ok := kv.blocks.Peek(slot)
+		if !ok {
+			continue
+		}
+		root := blk.HashTreeRoot()
+		if err := kv.verifyBlock(slot, root, blk); err != nil {
+			return errors.Wrapf(
+				err, "slot %d, block root %s", slot, root,
+			)
+		}
+	}
+
+	// Every indexed block root must point back to the block at its slot.
+	for _, root := range kv.blockRoots.Keys() {
+		blk, ok := kv.blockRoots.Peek(root)
+		if !ok {
+			continue
+		}
+		stored, ok := kv.blocks.Peek(blk.GetSlot())
+		if !ok || stored.HashTreeRoot() != root {
+			return errors.Wrapf(
+				ErrInconsistentIndex,
+				"block root index holds a block missing from the slot "+
+					"index: slot %d, block root %s",
+				blk.GetSlot(), root,
+			)
+		}
+	}
+	return nil
+}
+
+// verifyBlock checks that the reverse indexes map the given block, stored at
+// the given slot, back to that slot.
+func (kv *KVStore[BeaconBlockT]) verifyBlock(
+	slot math.Slot,
+	root common.Root,
+	blk BeaconBlockT,
+) error {
+	if blk.GetSlot() != slot {
+		return errors.Wrapf(
+			ErrInconsistentIndex,
+			"block stored at slot has slot %d", blk.GetSlot(),
+		)
+	}
+	if indexed, ok := kv.blockRoots.Peek(root); !ok ||
+		indexed.GetSlot() != slot {
+		return errors.Wrap(ErrInconsistentIndex, "block root index mismatch")
+	}
+	if indexed, ok := kv.stateRoots.Peek(blk.GetStateRoot()); !ok ||
+		indexed != slot {
+		return errors.Wrap(ErrInconsistentIndex, "state root index mismatch")
+	}
+	if indexed, ok := kv.timestamps.Peek(blk.GetTimestamp()); !ok ||
+		indexed != slot {
+		return errors.Wrap(ErrInconsistentIndex, "timestamp index mismatch")
+	}
+	if indexed, ok := kv.executionNumbers.Peek(
+		blk.GetExecutionNumber(),
+	); !ok || indexed.GetSlot() != slot {
+		return errors.Wrap(
+			ErrInconsistentIndex, "execution number index mismatch",
+		)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@
 package block_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/berachain/beacon-kit/mod/log/pkg/noop"
@@ -50,6 +51,10 @@ func (m MockBeaconBlock) GetStateRoot() common.Root {
 	return [32]byte{byte(m.slot)}
 }
 
+func (m MockBeaconBlock) GetExecutionNumber() math.U64 {
+	return m.slot * 2
+}
+
 func TestBlockStore(t *testing.T) {
 	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
 
@@ -86,3 +91,295 @@ func TestBlockStore(t *testing.T) {
 	_, err = blockStore.GetParentSlotByTimestamp(2)
 	require.ErrorContains(t, err, "not found")
 }
+
+func TestBlockStoreGetSlotsByBlockRoots(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	for i := 1; i <= 7; i++ {
+		err := blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
+		require.NoError(t, err)
+	}
+
+	// Get the slots of all blocks in the window at once.
+	roots := make([]common.Root, 0, 5)
+	for i := 3; i <= 7; i++ {
+		roots = append(roots, [32]byte{byte(i)})
+	}
+	slots, err := blockStore.GetSlotsByBlockRoots(roots)
+	require.NoError(t, err)
+	require.Len(t, slots, len(roots))
+	for i := math.Slot(3); i <= 7; i++ {
+		require.Equal(t, i, slots[[32]byte{byte(i)}])
+	}
+
+	// An empty batch resolves to an empty map.
+	slots, err = blockStore.GetSlotsByBlockRoots(nil)
+	require.NoError(t, err)
+	require.Empty(t, slots)
+
+	// Any evicted or unknown root fails the whole batch.
+	_, err = blockStore.GetSlotsByBlockRoots(
+		[]common.Root{{byte(7)}, {byte(2)}},
+	)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStoreGetRangeAndBounds(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+
+	// An empty store has no blocks to return.
+	_, _, err := blockStore.GetLatest()
+	require.ErrorIs(t, err, block.ErrNoBlocks)
+	_, _, err = blockStore.GetOldest()
+	require.ErrorIs(t, err, block.ErrNoBlocks)
+	_, err = blockStore.GetRange(0, 10)
+	require.ErrorIs(t, err, block.ErrNoBlocks)
+
+	for i := 1; i <= 7; i++ {
+		err = blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
+		require.NoError(t, err)
+	}
+
+	blk, slot, err := blockStore.GetLatest()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(7), slot)
+	require.Equal(t, math.Slot(7), blk.GetSlot())
+
+	// Blocks 1 and 2 fall out of the window.
+	blk, slot, err = blockStore.GetOldest()
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), slot)
+	require.Equal(t, math.Slot(3), blk.GetSlot())
+
+	// Evicted slots are skipped and the range end is exclusive.
+	blks, err := blockStore.GetRange(1, 6)
+	require.NoError(t, err)
+	require.Len(t, blks, 3)
+	for i, blk := range blks {
+		require.Equal(t, math.Slot(i+3), blk.GetSlot())
+	}
+
+	blks, err = blockStore.GetRange(8, 10)
+	require.NoError(t, err)
+	require.Empty(t, blks)
+}
+
+func TestBlockStoreGetSlotByExecutionNumberOrBelow(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+
+	// Execution numbers are twice the slot, leaving gaps between blocks.
+	for i := 1; i <= 7; i++ {
+		err := blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
+		require.NoError(t, err)
+	}
+
+	tests := []struct {
+		name            string
+		executionNumber math.U64
+		expectedSlot    math.Slot
+		expectedErr     error
+	}{
+		{name: "exact match", executionNumber: 10, expectedSlot: 5},
+		{name: "gap", executionNumber: 11, expectedSlot: 5},
+		{name: "above latest", executionNumber: 100, expectedSlot: 7},
+		{name: "lowest retained", executionNumber: 6, expectedSlot: 3},
+		{
+			name:            "below window",
+			executionNumber: 5,
+			expectedErr:     block.ErrNoBlockBelow,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, err := blockStore.GetSlotByExecutionNumberOrBelow(
+				tt.executionNumber,
+			)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedSlot, slot)
+		})
+	}
+
+	// The exact lookup does not fall back to lower execution numbers.
+	_, err := blockStore.GetSlotByExecutionNumber(11)
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestBlockStorePrune(t *testing.T) {
+	tests := []struct {
+		name          string
+		prune         func(*block.KVStore[*MockBeaconBlock]) error
+		expectedSlots []math.Slot
+	}{
+		{
+			name: "range",
+			prune: func(kv *block.KVStore[*MockBeaconBlock]) error {
+				return kv.Prune(2, 4)
+			},
+			expectedSlots: []math.Slot{1, 4, 5},
+		},
+		{
+			name: "before timestamp",
+			prune: func(kv *block.KVStore[*MockBeaconBlock]) error {
+				return kv.PruneBefore(3)
+			},
+			expectedSlots: []math.Slot{3, 4, 5},
+		},
+		{
+			name: "no block predates timestamp",
+			prune: func(kv *block.KVStore[*MockBeaconBlock]) error {
+				return kv.PruneBefore(1)
+			},
+			expectedSlots: []math.Slot{1, 2, 3, 4, 5},
+		},
+		{
+			name: "every block predates timestamp",
+			prune: func(kv *block.KVStore[*MockBeaconBlock]) error {
+				return kv.PruneBefore(10)
+			},
+			expectedSlots: []math.Slot{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blockStore := block.NewStore[*MockBeaconBlock](
+				noop.NewLogger[any](), 5,
+			)
+			for i := 1; i <= 5; i++ {
+				err := blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)})
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, tt.prune(blockStore))
+
+			for i := math.Slot(1); i <= 5; i++ {
+				_, err := blockStore.GetSlotByBlockRoot([32]byte{byte(i)})
+				if slices.Contains(tt.expectedSlots, i) {
+					require.NoError(t, err)
+				} else {
+					require.ErrorContains(t, err, "not found")
+				}
+			}
+		})
+	}
+}
+
+func TestReadOnlyStore(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	readOnly := block.NewReadOnlyStore(blockStore)
+
+	// The view does not hold any block yet.
+	_, err := readOnly.GetSlotByBlockRoot([32]byte{1})
+	require.Error(t, err)
+
+	// Blocks written to the store are visible through the view.
+	for i := 1; i <= 3; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+	slot, err := readOnly.GetSlotByBlockRoot([32]byte{2})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	slot, err = readOnly.GetSlotByStateRoot([32]byte{3})
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(3), slot)
+
+	slot, err = readOnly.GetParentSlotByTimestamp(3)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(2), slot)
+
+	slot, err = readOnly.GetSlotByExecutionNumber(2)
+	require.NoError(t, err)
+	require.Equal(t, math.Slot(1), slot)
+
+	slots, err := readOnly.GetSlotsByBlockRoots(
+		[]common.Root{{1}, {3}},
+	)
+	require.NoError(t, err)
+	require.Equal(t, map[common.Root]math.Slot{{1}: 1, {3}: 3}, slots)
+}
+
+func TestBlockStore_GetByRoot(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// The blocks in the availability window are found by their root.
+	for i := math.Slot(3); i <= 7; i++ {
+		blk, err := blockStore.GetByRoot([32]byte{byte(i)})
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+	}
+
+	// The evicted and pruned blocks are not found.
+	_, err := blockStore.GetByRoot([32]byte{2})
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+
+	require.NoError(t, blockStore.Prune(3, 4))
+	_, err = blockStore.GetByRoot([32]byte{3})
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
+
+func TestBlockStore_GetByExecutionNumber(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+
+	// The blocks in the availability window are found by their execution
+	// number, which is twice the slot for the mock blocks.
+	for i := math.Slot(3); i <= 7; i++ {
+		blk, err := blockStore.GetByExecutionNumber(i * 2)
+		require.NoError(t, err)
+		require.Equal(t, i, blk.GetSlot())
+	}
+
+	// Evicted blocks and unknown execution numbers are not found.
+	_, err := blockStore.GetByExecutionNumber(4)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+	_, err = blockStore.GetByExecutionNumber(7)
+	require.ErrorIs(t, err, block.ErrBlockNotFound)
+}
+
+// timestampedBlock is a mock beacon block with an arbitrary timestamp.
+type timestampedBlock struct {
+	MockBeaconBlock
+	timestamp math.U64
+}
+
+func (b timestampedBlock) GetTimestamp() math.U64 {
+	return b.timestamp
+}
+
+func TestBlockStore_Verify(t *testing.T) {
+	blockStore := block.NewStore[*MockBeaconBlock](noop.NewLogger[any](), 5)
+	require.NoError(t, blockStore.Verify())
+
+	for i := 1; i <= 7; i++ {
+		require.NoError(t, blockStore.Set(&MockBeaconBlock{slot: math.Slot(i)}))
+	}
+	require.NoError(t, blockStore.Verify())
+
+	require.NoError(t, blockStore.Prune(4, 6))
+	require.NoError(t, blockStore.Verify())
+}
+
+func TestBlockStore_VerifyInconsistent(t *testing.T) {
+	blockStore := block.NewStore[*timestampedBlock](noop.NewLogger[any](), 5)
+
+	// Two blocks sharing a timestamp leave the first one unreachable by its
+	// timestamp.
+	for i := 1; i <= 2; i++ {
+		require.NoError(t, blockStore.Set(&timestampedBlock{
+			MockBeaconBlock: MockBeaconBlock{slot: math.Slot(i)},
+			timestamp:       10,
+		}))
+	}
+
+	err := blockStore.Verify()
+	require.ErrorIs(t, err, block.ErrInconsistentIndex)
+	require.ErrorContains(t, err, "slot 1")
+}
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"slices"
+	"sync"
+
+	lru "github.com/hashicorp/golang-lru/v2"
+)
+
+// depositRange is the range of deposits read by GetDepositsByIndex.
+type depositRange struct {
+	startIndex uint64
+	numView    uint64
+}
+
+// CachingDepositStore is a read-through cache in front of a deposit store. It
+// caches the results of recent GetDepositsByIndex calls and drops them all
+// whenever deposits are enqueued or pruned.
+type CachingDepositStore[DepositT any] struct {
+	Store[DepositT]
+
+	// mu serializes writes against filling the cache, so that a range read
+	// before a write is never cached after it.
+	mu     sync.RWMutex
+	ranges *lru.Cache[depositRange, []DepositT]
+}
+
+// NewCachingStore wraps the given deposit store with a cache holding up to
+// cacheSize deposit ranges.
+func NewCachingStore[DepositT any](
+	store Store[DepositT],
+	cacheSize int,
+) (*CachingDepositStore[DepositT], error) {
+	ranges, err := lru.New[depositRange, []DepositT](cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &CachingDepositStore[DepositT]{
+		Store:  store,
+		ranges: ranges,
+	}, nil
+}
+
+// GetDepositsByIndex returns the first N deposits starting from the given
+// index, from the cache if the same range was read before.
+func (s *CachingDepositStore[DepositT]) GetDepositsByIndex(
+	startIndex uint64,
+	numView uint64,
+) ([]DepositT, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	key := depositRange{startIndex: startIndex, numView: numView}
+	if deposits, ok := s.ranges.Get(key); ok {
+		return slices.Clone(deposits), nil
+	}
+
+	deposits, err := s.Store.GetDepositsByIndex(startIndex, numView)
+	if err != nil {
+		return deposits, err
+	}
+	s.ranges.Add(key, slices.Clone(deposits))
+	return deposits, nil
+}
+
+// EnqueueDeposits adds the deposits to the underlying store and invalidates
+// the cache.
+func (s *CachingDepositStore[DepositT]) EnqueueDeposits(
+	deposits []DepositT,
+) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.ranges.Purge()
+	return s.Store.EnqueueDeposits(deposits)
+}
+
+// Prune removes the [start, end) deposits from the underlying store and
+// invalidates the cache.
+func (s *CachingDepositStore[DepositT]) Prune(start, end uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.ranges.Purge()
+	return s.Store.Prune(start, end)
+}
@@ -23,28 +23,52 @@ package deposit
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	sdkcollections "cosmossdk.io/collections"
 	"cosmossdk.io/core/store"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/encoding"
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
-const KeyDepositPrefix = "deposit"
+const (
+	KeyDepositPrefix = "deposit"
+
+	// depositRootCacheSize is the number of deposit roots kept in memory.
+	depositRootCacheSize = 256
+)
 
 // KVStore is a simple KV store based implementation that assumes
 // the deposit indexes are tracked outside of the kv store.
 type KVStore[DepositT Deposit[DepositT]] struct {
 	store sdkcollections.Map[uint64, DepositT]
 	mu    sync.RWMutex
+
+	// tree is the deposit tree over the first tree.count deposits. It is
+	// only ever advanced, so that consecutive roots are cheap to compute.
+	tree depositTree
+	// roots caches the deposit roots by deposit count.
+	roots *lru.Cache[uint64, common.Root]
+
+	// rejectDuplicates makes EnqueueDeposits fail on already seen deposits
+	// instead of skipping them.
+	rejectDuplicates bool
 }
 
 // NewStore creates a new deposit store.
 func NewStore[DepositT Deposit[DepositT]](
 	kvsp store.KVStoreService,
+	opts ...Option[DepositT],
 ) *KVStore[DepositT] {
+	roots, err := lru.New[uint64, common.Root](depositRootCacheSize)
+	if err != nil {
+		panic(err)
+	}
 	schemaBuilder := sdkcollections.NewSchemaBuilder(kvsp)
-	return &KVStore[DepositT]{
+	kv := &KVStore[DepositT]{
+		roots: roots,
 		store: sdkcollections.NewMap(
 			schemaBuilder,
 			sdkcollections.NewPrefix([]byte(KeyDepositPrefix)),
@@ -53,6 +77,10 @@ func NewStore[DepositT Deposit[DepositT]](
 			encoding.SSZValueCodec[DepositT]{},
 		),
 	}
+	for _, opt := range opts {
+		opt(kv)
+	}
+	return kv
 }
 
 // GetDepositsByIndex returns the first N deposits starting from the given
@@ -78,28 +106,142 @@ func (kv *KVStore[DepositT]) GetDepositsByIndex(
 	return deposits, nil
 }
 
-// EnqueueDeposit pushes the deposit to the queue.
+// IterateDeposits calls fn on the deposits starting from the given index, in
+// index order, until the first missing deposit. Deposits are read from the
+// store one at a time rather than loaded at once. If fn returns an error,
+// iteration stops and the error is returned.
+func (kv *KVStore[DepositT]) IterateDeposits(
+	startIndex uint64,
+	fn func(DepositT) error,
+) error {
+	kv.mu.RLock()
+	defer kv.mu.RUnlock()
+	iter, err := kv.store.Iterate(
+		context.TODO(),
+		new(sdkcollections.Range[uint64]).StartInclusive(startIndex),
+	)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for next := startIndex; iter.Valid(); iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return err
+		}
+		// Stop at the first gap, as GetDepositsByIndex does.
+		if entry.Key != next {
+			return nil
+		}
+		if err = fn(entry.Value); err != nil {
+			return err
+		}
+		next++
+	}
+	return nil
+}
+
+// GetDepositRootAtIndex returns the root of the deposit tree over the
+// deposits [0, index). Roots are cached, and the tree is advanced from the
+// highest index computed so far, so consecutive calls during block
+// verification only hash the new deposits.
+func (kv *KVStore[DepositT]) GetDepositRootAtIndex(
+	index uint64,
+) (common.Root, error) {
+	kv.mu.Lock()
+	defer kv.mu.Unlock()
+	if root, ok := kv.roots.Get(index); ok {
+		return root, nil
+	}
+
+	// Rebuild the tree from scratch if it is already past the index.
+	if index < kv.tree.count {
+		kv.tree = depositTree{}
+	}
+	for kv.tree.count < index {
+		deposit, err := kv.store.Get(context.TODO(), kv.tree.count)
+		if err != nil {
+			return common.Root{}, fmt.Errorf(
+				"failed to get deposit %d: %w", kv.tree.count, err,
+			)
+		}
+		kv.tree.push(deposit.HashTreeRoot())
+	}
+
+	root := kv.tree.root()
+	kv.roots.Add(index, root)
+	return root, nil
+}
+
+// EnqueueDeposit pushes the deposit to the queue, overwriting any deposit
+// already stored at its index.
 func (kv *KVStore[DepositT]) EnqueueDeposit(deposit DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
 	return kv.setDeposit(deposit)
 }
 
-// EnqueueDeposits pushes multiple deposits to the queue.
+// EnqueueDeposits pushes multiple deposits to the queue. Deposits whose index
+// is not above the highest stored index were already seen, e.g. when the
+// Eth1 follower re-enqueues deposits after a reorg. They are skipped, or
+// rejected with ErrDuplicateDeposit if the store was created with
+// WithRejectDuplicates.
 func (kv *KVStore[DepositT]) EnqueueDeposits(deposits []DepositT) error {
 	kv.mu.Lock()
 	defer kv.mu.Unlock()
+	highest, found, err := kv.highestIndex()
+	if err != nil {
+		return err
+	}
 	for _, deposit := range deposits {
-		if err := kv.setDeposit(deposit); err != nil {
+		index := deposit.GetIndex().Unwrap()
+		if found && index <= highest {
+			if kv.rejectDuplicates {
+				return fmt.Errorf(
+					"%w: index %d, highest stored index %d",
+					ErrDuplicateDeposit, index, highest,
+				)
+			}
+			continue
+		}
+		if err = kv.setDeposit(deposit); err != nil {
 			return err
 		}
+		highest, found = index, true
 	}
 	return nil
 }
 
-// setDeposit sets the deposit in the store.
+// highestIndex returns the highest stored deposit index, and false if the
+// store holds no deposits.
+func (kv *KVStore[DepositT]) highestIndex() (uint64, bool, error) {
+	iter, err := kv.store.Iterate(
+		context.TODO(), new(sdkcollections.Range[uint64]).Descending(),
+	)
+	if err != nil {
+		return 0, false, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0, false, nil
+	}
+	index, err := iter.Key()
+	if err != nil {
+		return 0, false, err
+	}
+	return index, true, nil
+}
+
+// setDeposit sets the deposit in the store. Overwriting a deposit that is
+// already part of the deposit tree invalidates the computed roots.
 func (kv *KVStore[DepositT]) setDeposit(deposit DepositT) error {
-	return kv.store.Set(context.TODO(), deposit.GetIndex().Unwrap(), deposit)
+	index := deposit.GetIndex().Unwrap()
+	if index < kv.tree.count {
+		kv.tree = depositTree{}
+		kv.roots.Purge()
+	}
+	return kv.store.Set(context.TODO(), index, deposit)
 }
 
 // Prune removes the [start, end) deposits from the store.
@@ -0,0 +1,229 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/berachain/beacon-kit/mod/consensus-types/pkg/types"
+	"github.com/berachain/beacon-kit/mod/node-core/pkg/components"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/db"
+	"github.com/berachain/beacon-kit/mod/storage/pkg/deposit"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var testStoreKey = storetypes.NewKVStoreKey("deposit-tests")
+
+type testKVStoreService struct {
+	ctx sdk.Context
+}
+
+func (kvs *testKVStoreService) OpenKVStore(context.Context) corestore.KVStore {
+	//nolint:contextcheck // fine with tests
+	return components.NewKVStore(
+		sdk.UnwrapSDKContext(kvs.ctx).KVStore(testStoreKey),
+	)
+}
+
+func newTestStore(
+	t *testing.T, opts ...deposit.Option[*types.Deposit],
+) *deposit.KVStore[*types.Deposit] {
+	t.Helper()
+	memDB, err := db.OpenDB("", dbm.MemDBBackend)
+	require.NoError(t, err)
+
+	nopLog := log.NewNopLogger()
+	cms := store.NewCommitMultiStore(
+		memDB, nopLog, metrics.NewNoOpMetrics(),
+	)
+	cms.MountStoreWithDB(testStoreKey, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	return deposit.NewStore[*types.Deposit](&testKVStoreService{
+		ctx: sdk.NewContext(cms, true, nopLog),
+	}, opts...)
+}
+
+func newDeposits(start, end uint64) []*types.Deposit {
+	deposits := make([]*types.Deposit, 0, end-start)
+	for i := start; i < end; i++ {
+		deposits = append(deposits, types.NewDeposit(
+			[48]byte{byte(i)}, types.WithdrawalCredentials{}, 32, [96]byte{}, i,
+		))
+	}
+	return deposits
+}
+
+func TestGetDepositRootAtIndex(t *testing.T) {
+	kv := newTestStore(t)
+	deposits := newDeposits(0, 8)
+	require.NoError(t, kv.EnqueueDeposits(deposits))
+
+	leaves := make([]common.Root, 0, len(deposits))
+	for _, d := range deposits {
+		leaves = append(leaves, d.HashTreeRoot())
+	}
+
+	// Compute the roots out of order to exercise rebuilding the tree.
+	for _, index := range []uint64{3, 8, 1, 5, 5} {
+		tree, err := merkle.NewTreeFromLeavesWithDepth(
+			slices.Clone(leaves[:index]), 32,
+		)
+		require.NoError(t, err)
+
+		root, err := kv.GetDepositRootAtIndex(index)
+		require.NoError(t, err)
+		require.Equal(t, tree.HashTreeRoot(), root)
+	}
+
+	// Overwriting a deposit in the tree invalidates the cached roots.
+	replaced := newDeposits(2, 3)[0]
+	replaced.Amount = 64
+	require.NoError(t, kv.EnqueueDeposit(replaced))
+	leaves[2] = replaced.HashTreeRoot()
+	tree, err := merkle.NewTreeFromLeavesWithDepth(
+		slices.Clone(leaves[:5]), 32,
+	)
+	require.NoError(t, err)
+	root, err := kv.GetDepositRootAtIndex(5)
+	require.NoError(t, err)
+	require.Equal(t, tree.HashTreeRoot(), root)
+
+	// The deposits must be in the store.
+	_, err = kv.GetDepositRootAtIndex(9)
+	require.Error(t, err)
+}
+
+func TestIterateDeposits(t *testing.T) {
+	kv := newTestStore(t)
+	require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 5)))
+	// Deposit 5 is missing, so deposits 6 and 7 are not reachable.
+	require.NoError(t, kv.EnqueueDeposits(newDeposits(6, 8)))
+
+	var indexes []uint64
+	require.NoError(t, kv.IterateDeposits(2, func(d *types.Deposit) error {
+		indexes = append(indexes, d.GetIndex().Unwrap())
+		return nil
+	}))
+	require.Equal(t, []uint64{2, 3, 4}, indexes)
+
+	// Returning an error from fn stops the iteration early.
+	errStop := errors.New("stop")
+	indexes = nil
+	err := kv.IterateDeposits(0, func(d *types.Deposit) error {
+		indexes = append(indexes, d.GetIndex().Unwrap())
+		if len(indexes) == 2 {
+			return errStop
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStop)
+	require.Equal(t, []uint64{0, 1}, indexes)
+}
+
+func TestEnqueueDepositsDuplicates(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        []deposit.Option[*types.Deposit]
+		expectedErr error
+	}{
+		{name: "skip duplicates"},
+		{
+			name: "reject duplicates",
+			opts: []deposit.Option[*types.Deposit]{
+				deposit.WithRejectDuplicates[*types.Deposit](),
+			},
+			expectedErr: deposit.ErrDuplicateDeposit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kv := newTestStore(t, tt.opts...)
+			require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 4)))
+
+			// Re-enqueue an overlapping batch with altered deposits.
+			overlapping := newDeposits(2, 6)
+			for _, d := range overlapping {
+				d.Amount = 64
+			}
+			err := kv.EnqueueDeposits(overlapping)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			// The seen deposits are kept and the new ones are appended.
+			deposits, err := kv.GetDepositsByIndex(0, 10)
+			require.NoError(t, err)
+			require.Len(t, deposits, 6)
+			for i, d := range deposits {
+				require.Equal(t, uint64(i), d.GetIndex().Unwrap())
+				if i < 4 {
+					require.Equal(t, uint64(32), d.Amount.Unwrap())
+				} else {
+					require.Equal(t, uint64(64), d.Amount.Unwrap())
+				}
+			}
+		})
+	}
+}
+
+func TestCachingDepositStore(t *testing.T) {
+	kv := newTestStore(t)
+	require.NoError(t, kv.EnqueueDeposits(newDeposits(0, 3)))
+	cache, err := deposit.NewCachingStore[*types.Deposit](kv, 8)
+	require.NoError(t, err)
+
+	deposits, err := cache.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	// Writes to the underlying store bypass the cache and are not seen.
+	require.NoError(t, kv.EnqueueDeposits(newDeposits(3, 4)))
+	deposits, err = cache.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 3)
+
+	// Enqueuing through the cache invalidates it.
+	require.NoError(t, cache.EnqueueDeposits(newDeposits(4, 5)))
+	deposits, err = cache.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Len(t, deposits, 5)
+
+	// So does pruning.
+	require.NoError(t, cache.Prune(0, 1))
+	deposits, err = cache.GetDepositsByIndex(0, 5)
+	require.NoError(t, err)
+	require.Empty(t, deposits)
+}
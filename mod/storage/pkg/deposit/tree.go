@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2024, Berachain Foundation. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN “AS IS” BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package deposit
+
+import (
+	"encoding/binary"
+
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/crypto/sha256"
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/merkle/zero"
+)
+
+// depositTreeDepth is the depth of the deposit contract Merkle tree.
+const depositTreeDepth = 32
+
+// depositTree is an incremental Merkle tree over the deposit roots, built the
+// same way as the deposit contract builds it. Only the rightmost branch is
+// kept, so appending a deposit and computing the root are O(depth).
+type depositTree struct {
+	branch [depositTreeDepth]common.Root
+	count  uint64
+}
+
+// push appends the given leaf to the tree.
+func (t *depositTree) push(leaf common.Root) {
+	node := leaf
+	size := t.count + 1
+	for height := range depositTreeDepth {
+		if size&1 == 1 {
+			t.branch[height] = node
+			break
+		}
+		node = hashPair(t.branch[height], node)
+		size >>= 1
+	}
+	t.count++
+}
+
+// root returns the root of the tree, mixed in with the number of leaves.
+func (t *depositTree) root() common.Root {
+	var node common.Root
+	size := t.count
+	for height := range depositTreeDepth {
+		if size&1 == 1 {
+			node = hashPair(t.branch[height], node)
+		} else {
+			node = hashPair(node, common.Root(zero.Hashes[height]))
+		}
+		size >>= 1
+	}
+
+	var length common.Root
+	binary.LittleEndian.PutUint64(length[:], t.count)
+	return hashPair(node, length)
+}
+
+// hashPair returns the hash of the concatenation of the given nodes.
+func hashPair(a, b common.Root) common.Root {
+	var buf [64]byte
+	copy(buf[:32], a[:])
+	copy(buf[32:], b[:])
+	return common.Root(sha256.Hash(buf[:]))
+}
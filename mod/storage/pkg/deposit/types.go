@@ -21,6 +21,7 @@
 package deposit
 
 import (
+	"github.com/berachain/beacon-kit/mod/primitives/pkg/common"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/constraints"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/math"
 )
@@ -30,4 +31,22 @@ type Deposit[DepositT any] interface {
 	constraints.SSZMarshallable
 	constraints.Empty[DepositT]
 	GetIndex() math.U64
+	HashTreeRoot() common.Root
+}
+
+// Store is the interface implemented by the deposit stores.
+type Store[DepositT any] interface {
+	// GetDepositsByIndex returns `numView` deposits starting from the given
+	// index.
+	GetDepositsByIndex(startIndex uint64, numView uint64) ([]DepositT, error)
+	// IterateDeposits calls fn on the deposits starting from the given
+	// index until fn returns an error.
+	IterateDeposits(startIndex uint64, fn func(DepositT) error) error
+	// GetDepositRootAtIndex returns the root of the deposit tree over the
+	// deposits [0, index).
+	GetDepositRootAtIndex(index uint64) (common.Root, error)
+	// EnqueueDeposits adds a list of deposits to the store.
+	EnqueueDeposits(deposits []DepositT) error
+	// Prune removes the [start, end) deposits from the store.
+	Prune(start, end uint64) error
 }
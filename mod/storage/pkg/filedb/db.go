@@ -29,6 +29,9 @@ import (
 	"github.com/spf13/afero"
 )
 
+// tmpSuffix is the suffix of the files staged by SetBatch.
+const tmpSuffix = ".tmp"
+
 // DB represents a filesystem backed key-value store.
 // It is useful for storing amounts of data that exceed what is
 // performant to store in a traditional key-value database.
@@ -96,6 +99,55 @@ func (db *DB) Set(key []byte, value []byte) error {
 	return nil
 }
 
+// SetBatch stores the values for multiple keys. Every value is first written
+// to a temporary file, and the files are only moved into place once all of
+// them were written, so a failed write never leaves part of the batch set.
+func (db *DB) SetBatch(kvs []struct{ Key, Value []byte }) error {
+	tmpPaths := make([]string, 0, len(kvs))
+	removeTmp := func() {
+		for _, path := range tmpPaths {
+			_ = db.fs.Remove(path)
+		}
+	}
+
+	for _, kv := range kvs {
+		path := db.pathForKey(kv.Key)
+		if err := db.fs.MkdirAll(filepath.Dir(path), db.dirPerms); err != nil {
+			removeTmp()
+			return err
+		}
+		tmpPaths = append(tmpPaths, path+tmpSuffix)
+		if err := db.writeFile(path+tmpSuffix, kv.Value); err != nil {
+			removeTmp()
+			return err
+		}
+	}
+
+	for i, kv := range kvs {
+		if err := db.fs.Rename(
+			tmpPaths[i], db.pathForKey(kv.Key),
+		); err != nil {
+			removeTmp()
+			return errors.Wrap(err, "failed to move file into place")
+		}
+	}
+	return nil
+}
+
+// writeFile creates the file at the given path and writes the value to it.
+func (db *DB) writeFile(path string, value []byte) error {
+	file, err := db.fs.Create(path)
+	if err != nil {
+		return errors.Wrap(err, "failed to create file")
+	}
+	defer file.Close()
+
+	if _, err = file.Write(value); err != nil {
+		return errors.Wrap(err, "failed to write to file")
+	}
+	return nil
+}
+
 // Delete removes the value for a key.
 func (db *DB) Delete(key []byte) error {
 	return db.fs.RemoveAll(db.pathForKey(key))
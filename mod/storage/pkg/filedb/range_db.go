@@ -23,12 +23,16 @@ package filedb
 import (
 	"bytes"
 	"fmt"
+	"io/fs"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/encoding/hex"
 	db "github.com/berachain/beacon-kit/mod/storage/pkg/interfaces"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
+	"github.com/spf13/afero"
 )
 
 // two is a constant for the number 2.
@@ -78,6 +82,126 @@ func (db *RangeDB) Set(index uint64, key []byte, value []byte) error {
 	return db.DB.Set(db.prefix(index, key), value)
 }
 
+// SetBatch stores the values for multiple keys at the given index, such that
+// either all or none of them are set if a write fails. The keys are prefixed
+// as in Set.
+func (db *RangeDB) SetBatch(
+	index uint64,
+	kvs []struct{ Key, Value []byte },
+) error {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return errors.New("rangedb: set batch not supported for this db")
+	}
+
+	prefixed := make([]struct{ Key, Value []byte }, len(kvs))
+	for i, kv := range kvs {
+		prefixed[i].Key = db.prefix(index, kv.Key)
+		prefixed[i].Value = kv.Value
+	}
+	if err := f.SetBatch(prefixed); err != nil {
+		return err
+	}
+
+	// enforce invariant
+	if index < db.firstNonNilIndex {
+		db.firstNonNilIndex = index
+	}
+	return nil
+}
+
+// GetRange retrieves all values stored at the indices in the range
+// [start, end). The filesystem is listed once rather than probed per index,
+// and indices that hold no values or have been pruned are skipped.
+func (db *RangeDB) GetRange(start, end uint64) (map[uint64][][]byte, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return nil, errors.New("rangedb: get range not supported for this db")
+	}
+
+	values := make(map[uint64][][]byte)
+	dirs, err := afero.ReadDir(f.fs, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return values, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	start = max(start, db.firstNonNilIndex)
+	for _, dir := range dirs {
+		index, parseErr := strconv.ParseUint(dir.Name(), 10, 64)
+		if !dir.IsDir() || parseErr != nil || index < start || index >= end {
+			continue
+		}
+
+		var files []fs.FileInfo
+		files, err = afero.ReadDir(f.fs, dir.Name())
+		if errors.Is(err, fs.ErrNotExist) {
+			// The index was pruned after listing.
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			// Skip files staged by an interrupted SetBatch.
+			if file.IsDir() || strings.HasSuffix(file.Name(), tmpSuffix) {
+				continue
+			}
+			var bz []byte
+			bz, err = afero.ReadFile(
+				f.fs, filepath.Join(dir.Name(), file.Name()),
+			)
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			values[index] = append(values[index], bz)
+		}
+	}
+	return values, nil
+}
+
+// HasAny returns true if any value is stored at an index in the range
+// [start, end). The filesystem is listed once and the check stops at the
+// first index holding a value.
+func (db *RangeDB) HasAny(start, end uint64) (bool, error) {
+	f, ok := db.DB.(*DB)
+	if !ok {
+		return false, errors.New("rangedb: has any not supported for this db")
+	}
+
+	dirs, err := afero.ReadDir(f.fs, ".")
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	start = max(start, db.firstNonNilIndex)
+	for _, dir := range dirs {
+		index, parseErr := strconv.ParseUint(dir.Name(), 10, 64)
+		if !dir.IsDir() || parseErr != nil || index < start || index >= end {
+			continue
+		}
+
+		var files []fs.FileInfo
+		files, err = afero.ReadDir(f.fs, dir.Name())
+		if errors.Is(err, fs.ErrNotExist) {
+			// The index was pruned after listing.
+			continue
+		} else if err != nil {
+			return false, err
+		}
+		for _, file := range files {
+			if !file.IsDir() && !strings.HasSuffix(file.Name(), tmpSuffix) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
 // Delete removes the value associated with the given index and key from the
 // database. It prefixes the key with the index and a slash before deleting it
 // from the underlying database.
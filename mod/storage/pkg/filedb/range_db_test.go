@@ -192,6 +192,107 @@ func TestExtractIndex(t *testing.T) {
 	}
 }
 
+func TestRangeDB_GetRange(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	// Populate indices 1, 2, 4 and 6, leaving gaps at 3 and 5.
+	for _, index := range []uint64{1, 2, 4, 6} {
+		require.NoError(t, rdb.Set(index, []byte("a"), []byte{byte(index)}))
+		require.NoError(t, rdb.Set(index, []byte("b"), []byte{byte(index)}))
+	}
+
+	values, err := rdb.GetRange(2, 6)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][][]byte{
+		2: {{2}, {2}},
+		4: {{4}, {4}},
+	}, values)
+
+	// Pruned indices are skipped rather than reported as errors.
+	require.NoError(t, rdb.Prune(0, 3))
+	values, err = rdb.GetRange(0, 10)
+	require.NoError(t, err)
+	require.Equal(t, map[uint64][][]byte{
+		4: {{4}, {4}},
+		6: {{6}, {6}},
+	}, values)
+
+	// An empty range yields no values.
+	values, err = rdb.GetRange(7, 7)
+	require.NoError(t, err)
+	require.Empty(t, values)
+}
+
+func TestRangeDB_HasAny(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	// An empty db holds no values.
+	has, err := rdb.HasAny(0, 10)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	for _, index := range []uint64{2, 5} {
+		require.NoError(t, rdb.Set(index, []byte("a"), []byte{byte(index)}))
+	}
+
+	tests := []struct {
+		name       string
+		start, end uint64
+		expected   bool
+	}{
+		{name: "covers index", start: 0, end: 3, expected: true},
+		{name: "end is exclusive", start: 3, end: 5, expected: false},
+		{name: "start is inclusive", start: 5, end: 6, expected: true},
+		{name: "above all indices", start: 6, end: 10, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			has, err = rdb.HasAny(tt.start, tt.end)
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, has)
+		})
+	}
+
+	// Pruned ranges are empty.
+	require.NoError(t, rdb.Prune(0, 3))
+	has, err = rdb.HasAny(0, 3)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestRangeDB_SetBatch(t *testing.T) {
+	rdb := file.NewRangeDB(newTestFDB(t.TempDir()))
+
+	require.NoError(t, rdb.SetBatch(3, []struct{ Key, Value []byte }{
+		{Key: []byte("a"), Value: []byte{1}},
+		{Key: []byte("b"), Value: []byte{2}},
+	}))
+	for key, value := range map[string]byte{"a": 1, "b": 2} {
+		bz, err := rdb.Get(3, []byte(key))
+		require.NoError(t, err)
+		require.Equal(t, []byte{value}, bz)
+	}
+
+	// No staged files are left behind.
+	values, err := rdb.GetRange(3, 4)
+	require.NoError(t, err)
+	require.Len(t, values[3], 2)
+}
+
+func TestRangeDB_SetBatch_NotSupported(t *testing.T) {
+	rdb := file.NewRangeDB(new(mocks.DB))
+	err := rdb.SetBatch(1, []struct{ Key, Value []byte }{
+		{Key: []byte("a"), Value: []byte{1}},
+	})
+	require.EqualError(t, err, "rangedb: set batch not supported for this db")
+}
+
+func TestRangeDB_GetRange_NotSupported(t *testing.T) {
+	rdb := file.NewRangeDB(new(mocks.DB))
+	_, err := rdb.GetRange(1, 4)
+	require.EqualError(t, err, "rangedb: get range not supported for this db")
+}
+
 // =========================== PRUNING =====================================
 
 func TestRangeDB_DeleteRange_NotSupported(t *testing.T) {
@@ -28,6 +28,7 @@ package manager
 import (
 	"context"
 
+	"github.com/berachain/beacon-kit/mod/errors"
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 )
@@ -60,3 +61,17 @@ func (m *DBManager) Start(ctx context.Context) error {
 	}
 	return nil
 }
+
+// Close closes all pruners, waiting for their in-flight prunes to complete or
+// for the context to be done.
+func (m *DBManager) Close(ctx context.Context) error {
+	errs := make([]error, 0, len(m.pruners))
+	for _, pruner := range m.pruners {
+		if err := pruner.Close(ctx); err != nil {
+			errs = append(errs, errors.Wrapf(
+				err, "failed to close pruner %s", pruner.Name(),
+			))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -68,3 +68,32 @@ func TestDBManager_Start(t *testing.T) {
 	time.Sleep(100 * time.Millisecond)
 	mockPrunable.AssertNotCalled(t, "PruneFromInclusive")
 }
+
+func TestDBManager_Close(t *testing.T) {
+	mockPrunable := new(mocks.Prunable)
+	ch := make(chan async.Event[manager.BeaconBlock])
+	pruneParamsFn := func(
+		_ async.Event[manager.BeaconBlock],
+	) (uint64, uint64) {
+		return 0, 0
+	}
+
+	logger := log.NewNopLogger()
+	p1 := pruner.NewPruner[
+		manager.BeaconBlock,
+		*mocks.Prunable,
+	](logger, mockPrunable, "pruner1", ch, pruneParamsFn)
+	p2 := pruner.NewPruner[
+		manager.BeaconBlock,
+		*mocks.Prunable,
+	](logger, mockPrunable, "pruner2", ch, pruneParamsFn)
+
+	m, err := manager.NewDBManager(logger, p1, p2)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	require.NoError(t, m.Start(ctx))
+	require.NoError(t, m.Close(ctx))
+}
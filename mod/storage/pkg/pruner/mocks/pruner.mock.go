@@ -22,6 +22,52 @@ func (_m *Pruner[PrunableT]) EXPECT() *Pruner_Expecter[PrunableT] {
 	return &Pruner_Expecter[PrunableT]{mock: &_m.Mock}
 }
 
+// Close provides a mock function with given fields: ctx
+func (_m *Pruner[PrunableT]) Close(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Pruner_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type Pruner_Close_Call[PrunableT pruner.Prunable] struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Pruner_Expecter[PrunableT]) Close(ctx interface{}) *Pruner_Close_Call[PrunableT] {
+	return &Pruner_Close_Call[PrunableT]{Call: _e.mock.On("Close", ctx)}
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) Run(run func(ctx context.Context)) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) Return(_a0 error) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Pruner_Close_Call[PrunableT]) RunAndReturn(run func(context.Context) error) *Pruner_Close_Call[PrunableT] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Name provides a mock function with given fields:
 func (_m *Pruner[PrunableT]) Name() string {
 	ret := _m.Called()
@@ -28,6 +28,8 @@ package pruner
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"github.com/berachain/beacon-kit/mod/log"
 	"github.com/berachain/beacon-kit/mod/primitives/pkg/async"
@@ -47,6 +49,15 @@ type pruner[
 	name                    string
 	subBeaconBlockFinalized chan async.Event[BeaconBlockT]
 	pruneRangeFn            func(async.Event[BeaconBlockT]) (uint64, uint64)
+
+	// started reports whether the pruner was started.
+	started atomic.Bool
+	// closing is closed to stop accepting new prune triggers.
+	closing   chan struct{}
+	closeOnce sync.Once
+	// done is closed once the pruner stopped listening, after any in-flight
+	// prune completed.
+	done chan struct{}
 }
 
 // NewPruner creates a new Pruner.
@@ -66,21 +77,43 @@ func NewPruner[
 		name:                    name,
 		pruneRangeFn:            pruneRangeFn,
 		subBeaconBlockFinalized: subBeaconBlockFinalized,
+		closing:                 make(chan struct{}),
+		done:                    make(chan struct{}),
 	}
 }
 
 // Start starts the Pruner by listening for new indexes to prune.
 func (p *pruner[BeaconBlockT, PrunableT]) Start(ctx context.Context) {
+	p.started.Store(true)
 	go p.listen(ctx)
 }
 
+// Close stops the Pruner from accepting new indexes to prune and waits for
+// the in-flight prune, if any, to complete or for the context to be done.
+func (p *pruner[_, _]) Close(ctx context.Context) error {
+	p.closeOnce.Do(func() { close(p.closing) })
+	if !p.started.Load() {
+		return nil
+	}
+
+	select {
+	case <-p.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // listen listens for new finalized blocks and prunes the prunable store based
 // on the received finalized block event.
 func (p *pruner[_, PrunableT]) listen(ctx context.Context) {
+	defer close(p.done)
 	for {
 		select {
 		case <-ctx.Done():
 			return
+		case <-p.closing:
+			return
 		case event := <-p.subBeaconBlockFinalized:
 			p.onFinalizeBlock(event)
 		}
@@ -36,6 +36,7 @@ import (
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner"
 	"github.com/berachain/beacon-kit/mod/storage/pkg/pruner/mocks"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func pruneRangeFn[BlockT pruner.BeaconBlock](
@@ -122,3 +123,70 @@ func TestPruner(t *testing.T) {
 		})
 	}
 }
+
+func TestPrunerClose(t *testing.T) {
+	logger := log.NewNopLogger()
+	ch := make(chan async.Event[pruner.BeaconBlock])
+	pruning := make(chan struct{})
+	release := make(chan struct{})
+	mockPrunable := new(mocks.Prunable)
+	mockPrunable.On("Prune", mock.Anything, mock.Anything).
+		Run(func(mock.Arguments) {
+			close(pruning)
+			<-release
+		}).
+		Return(nil)
+
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.Prunable,
+	](logger, mockPrunable, "TestPruner", ch, pruneRangeFn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testPruner.Start(ctx)
+
+	block := mocks.BeaconBlock{}
+	block.On("GetSlot").Return(math.U64(1))
+	ch <- async.NewEvent[pruner.BeaconBlock](
+		context.Background(), async.BeaconBlockFinalized, &block,
+	)
+	<-pruning
+
+	// Closing times out while the prune is in flight.
+	timeoutCtx, timeoutCancel := context.WithTimeout(
+		context.Background(), 50*time.Millisecond,
+	)
+	defer timeoutCancel()
+	require.ErrorIs(
+		t, testPruner.Close(timeoutCtx), context.DeadlineExceeded,
+	)
+
+	// Closing returns once the in-flight prune completed.
+	closed := make(chan error)
+	go func() { closed <- testPruner.Close(ctx) }()
+	close(release)
+	require.NoError(t, <-closed)
+	mockPrunable.AssertNumberOfCalls(t, "Prune", 1)
+
+	// A closed pruner does not accept new prune triggers.
+	select {
+	case ch <- async.NewEvent[pruner.BeaconBlock](
+		context.Background(), async.BeaconBlockFinalized, &block,
+	):
+		t.Fatal("closed pruner accepted a prune trigger")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestPrunerCloseNotStarted(t *testing.T) {
+	testPruner := pruner.NewPruner[
+		pruner.BeaconBlock,
+		pruner.Prunable,
+	](
+		log.NewNopLogger(), new(mocks.Prunable), "TestPruner",
+		make(chan async.Event[pruner.BeaconBlock]), pruneRangeFn,
+	)
+	require.NoError(t, testPruner.Close(context.Background()))
+}
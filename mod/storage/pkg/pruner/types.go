@@ -55,4 +55,7 @@ type Prunable interface {
 type Pruner[PrunableT Prunable] interface {
 	Name() string
 	Start(ctx context.Context)
+	// Close stops the pruner and waits for its in-flight prune to complete
+	// or for the context to be done.
+	Close(ctx context.Context) error
 }